@@ -0,0 +1,66 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/dantedoyl/car-life-api/internal/app/bootstrap"
+	"github.com/dantedoyl/car-life-api/internal/app/config"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"github.com/dantedoyl/car-life-api/internal/app/maintenance"
+	"os"
+	"time"
+)
+
+func runCleanup(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("cleanup", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "only list the orphaned images, delete nothing")
+	imageGrace := flags.Duration("image-grace", time.Hour, "keep unreferenced images younger than this, their upload may still be in progress")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	app, err := bootstrap.New(cfg, logger.New(os.Stderr))
+	if err != nil {
+		return err
+	}
+	defer app.Close()
+
+	m := maintenance.NewMaintenance(app.Postgres.GetDatabase(), app.Sessions, app.Idempotency)
+	now := time.Now()
+
+	if !*dryRun {
+		deleted, err := m.DeleteEndedMiniEvents(now)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("deleted %d ended mini events\n", deleted)
+
+		deleted, err = m.DeleteExpiredSessions(now)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("deleted %d expired sessions and refresh tokens\n", deleted)
+
+		deleted, err = m.DeleteExpiredIdempotencyKeys(now.Add(-cfg.Idempotency.TTL))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("deleted %d expired idempotency keys\n", deleted)
+	}
+
+	images, err := m.DeleteOrphanedImages(now.Add(-*imageGrace), *dryRun)
+	for _, image := range images {
+		fmt.Println(image)
+	}
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		fmt.Printf("found %d orphaned images\n", len(images))
+	} else {
+		fmt.Printf("deleted %d orphaned images\n", len(images))
+	}
+
+	return nil
+}
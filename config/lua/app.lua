@@ -1,5 +1,8 @@
 #!/usr/bin/env tarantool
 
+local json = require('json')
+local fiber = require('fiber')
+
 -- Настроить базу данных
 box.cfg {
     listen = 3301
@@ -14,8 +17,136 @@ box.once('init', function()
 
 end)
 
+-- Вторичный индекс по пользователю: {value, data, user_id}.
+-- Старые сессии хранились как {value, data}, user_id для них берем из data,
+-- чтобы пользователей не разлогинило. Сессии с нечитаемой data удаляем.
+box.once('sessions_user_index', function()
+    local user_ids = {}
+    local broken = {}
+    for _, tuple in box.space.sessions:pairs() do
+        if tuple[3] == nil then
+            local ok, data = pcall(json.decode, tuple[2])
+            if ok and type(data) == 'table' and type(data.user_id) == 'number' and data.user_id > 0 then
+                user_ids[tuple[1]] = data.user_id
+            else
+                table.insert(broken, tuple[1])
+            end
+        end
+    end
+
+    box.begin()
+    for value, user_id in pairs(user_ids) do
+        box.space.sessions:update({value}, {{'!', 3, user_id}})
+    end
+    for _, value in ipairs(broken) do
+        box.space.sessions:delete{value}
+    end
+    box.commit()
+
+    box.space.sessions:create_index('user',
+        { type = 'TREE', unique = false, parts = {3, 'unsigned'}})
+end)
+
+-- Refresh токены: {value, data, family_id, used, user_id}
+box.once('refresh_tokens', function()
+    box.schema.space.create('refresh_tokens')
+    box.space.refresh_tokens:create_index('primary',
+        { type = 'TREE', parts = {1, 'string'}})
+    box.space.refresh_tokens:create_index('family',
+        { type = 'TREE', unique = false, parts = {3, 'string'}})
+    box.space.refresh_tokens:create_index('user',
+        { type = 'TREE', unique = false, parts = {5, 'unsigned'}})
+end)
+
+-- Токен-бакеты ограничения запросов: {key, tokens, last, full_at}
+box.once('rate_limits', function()
+    box.schema.space.create('rate_limits')
+    box.space.rate_limits:create_index('primary',
+        { type = 'TREE', parts = {1, 'string'}})
+    box.space.rate_limits:create_index('full_at',
+        { type = 'TREE', unique = false, parts = {4, 'number'}})
+end)
+
 function check_session(session_id)
     local session_id = box.space.sessions:select{session_id}[1]
     print('found session', session_id)
     return session_id
-end
\ No newline at end of file
+end
+
+function delete_user_sessions(user_id)
+    local values = {}
+    for _, tuple in box.space.sessions.index.user:pairs({user_id}) do
+        table.insert(values, tuple[1])
+    end
+    local tokens = {}
+    for _, tuple in box.space.refresh_tokens.index.user:pairs({user_id}) do
+        table.insert(tokens, tuple[1])
+    end
+
+    box.begin()
+    for _, value in ipairs(values) do
+        box.space.sessions:delete{value}
+    end
+    for _, value in ipairs(tokens) do
+        box.space.refresh_tokens:delete{value}
+    end
+    box.commit()
+end
+
+-- Помечает токен использованным и возвращает его состояние до изменения
+function use_refresh_token(value)
+    local token = box.space.refresh_tokens:get{value}
+    if token == nil then
+        return nil
+    end
+    box.space.refresh_tokens:update({value}, {{'=', 4, true}})
+    return token
+end
+
+function delete_refresh_family(family_id)
+    local tokens = {}
+    for _, tuple in box.space.refresh_tokens.index.family:pairs({family_id}) do
+        table.insert(tokens, {tuple[1], json.decode(tuple[2]).session_value})
+    end
+
+    box.begin()
+    for _, token in ipairs(tokens) do
+        box.space.refresh_tokens:delete{token[1]}
+        box.space.sessions:delete{token[2]}
+    end
+    box.commit()
+end
+
+-- Пополняет бакет key и берет из него токен.
+-- Возвращает 0 или сколько секунд ждать следующего токена.
+function take_token(key, rate, burst)
+    local now = fiber.time()
+    local tokens = burst
+    local bucket = box.space.rate_limits:get{key}
+    if bucket ~= nil then
+        tokens = math.min(burst, bucket[2] + math.max(0, now - bucket[3]) * rate)
+    end
+
+    local wait = 0
+    if tokens < 1 then
+        wait = (1 - tokens) / rate
+    else
+        tokens = tokens - 1
+    end
+    box.space.rate_limits:replace{key, tokens, now, now + (burst - tokens) / rate}
+    return wait
+end
+
+-- Раз в минуту удаляем полные бакеты, они ничем не отличаются от отсутствующих
+fiber.create(function()
+    while true do
+        fiber.sleep(60)
+        local keys = {}
+        for _, tuple in box.space.rate_limits.index.full_at:pairs({fiber.time()}, { iterator = 'LT' }) do
+            table.insert(keys, tuple[1])
+        end
+        for _, key in ipairs(keys) do
+            box.space.rate_limits:delete{key}
+        end
+    end
+end)
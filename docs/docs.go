@@ -16,6 +16,224 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/admin/complaints": {
+            "get": {
+                "description": "Handler for getting complaints across the platform. Available to platform staff only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "get complaints",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "club, event, post, car or user",
+                        "name": "TargetType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "open, resolved or dismissed",
+                        "name": "Status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "Limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.AdminComplaint"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/complaints/{id}": {
+            "get": {
+                "description": "Handler for getting a complaint with the object it is about and the moderation history. Available to platform staff only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "get complaint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Complaint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ComplaintDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/complaints/{id}/resolve": {
+            "post": {
+                "description": "Handler for resolving an open complaint. Action is one of dismiss, hide, delete or ban; ban bans the author of the reported object. Available to platform staff only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "resolve complaint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Complaint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Resolution",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ResolveComplaintRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            }
+        },
         "/club/create": {
             "post": {
                 "description": "Handler for creating a club",
@@ -38,6 +256,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/models.CreateClubRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the first response to a retry with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -53,6 +277,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -83,15 +313,9 @@ const docTemplate = `{
                 "summary": "get clubs list",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "IdGt",
-                        "name": "IdGt",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "IdLte",
-                        "name": "IdLte",
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
                         "in": "query"
                     },
                     {
@@ -111,10 +335,22 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.ClubCard"
-                            }
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.ClubCard"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -233,7 +469,16 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": ""
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -241,6 +486,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -274,13 +525,432 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Club"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Handler for changing the name, description and tags of a club. Only the fields in the body change; a new name renames the VK chat of the club too",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Clubs"
+                ],
+                "summary": "update a club",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Club ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Club",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateClubRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Club"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/clubs/{id}/cars": {
+            "get": {
+                "description": "Handler for getting tags list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Clubs"
+                ],
+                "summary": "get clubs cars list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Club ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "Limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.CarCard"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/clubs/{id}/chat_link": {
+            "get": {
+                "description": "Handler for getting tags list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Clubs"
+                ],
+                "summary": "get club chat link",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Club ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ChatLink"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/clubs/{id}/complain": {
+            "post": {
+                "description": "Handler for complaining club",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Clubs"
+                ],
+                "summary": "complain club",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Club ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Club",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ComplaintReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/clubs/{id}/complaints": {
+            "get": {
+                "description": "Handler for getting open complaints about the club, its events and posts in its events. Available to the club admin and moderators",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Clubs"
+                ],
+                "summary": "get club complaints",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Club ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "Limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.ComplaintCard"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/clubs/{id}/complaints/{complaint_id}/dismiss": {
+            "post": {
+                "description": "Handler for dismissing a complaint about the club, its events or posts. Available to the club admin and moderators",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Clubs"
+                ],
+                "summary": "dismiss club complaint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Club ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Complaint ID",
+                        "name": "complaint_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.Club"
+                            "$ref": "#/definitions/utils.Error"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/utils.Error"
                         }
@@ -300,9 +970,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/clubs/{id}/cars": {
-            "get": {
-                "description": "Handler for getting tags list",
+        "/clubs/{id}/delete": {
+            "post": {
+                "description": "Handler for deleting club",
                 "consumes": [
                     "application/json"
                 ],
@@ -312,7 +982,7 @@ const docTemplate = `{
                 "tags": [
                     "Clubs"
                 ],
-                "summary": "get clubs cars list",
+                "summary": "delete club",
                 "parameters": [
                     {
                         "type": "integer",
@@ -320,35 +990,11 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "IdGt",
-                        "name": "IdGt",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "IdLte",
-                        "name": "IdLte",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "Limit",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.CarCard"
-                            }
-                        }
+                        "description": ""
                     },
                     "400": {
                         "description": "Bad Request",
@@ -356,6 +1002,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -371,7 +1029,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/clubs/{id}/chat_link": {
+        "/clubs/{id}/events": {
             "get": {
                 "description": "Handler for getting tags list",
                 "consumes": [
@@ -383,7 +1041,7 @@ const docTemplate = `{
                 "tags": [
                     "Clubs"
                 ],
-                "summary": "get club chat link",
+                "summary": "get clubs events list",
                 "parameters": [
                     {
                         "type": "integer",
@@ -391,13 +1049,40 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "Limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ChatLink"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.EventCard"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -406,9 +1091,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
-                    "401": {
-                        "description": ""
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -424,9 +1106,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/clubs/{id}/complain": {
+        "/clubs/{id}/leave": {
             "post": {
-                "description": "Handler for complaining club",
+                "description": "Handler for leaving club",
                 "consumes": [
                     "application/json"
                 ],
@@ -436,7 +1118,7 @@ const docTemplate = `{
                 "tags": [
                     "Clubs"
                 ],
-                "summary": "complain club",
+                "summary": "leave club",
                 "parameters": [
                     {
                         "type": "integer",
@@ -444,15 +1126,6 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Club",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.ComplaintReq"
-                        }
                     }
                 ],
                 "responses": {
@@ -486,9 +1159,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/clubs/{id}/delete": {
-            "post": {
-                "description": "Handler for deleting club",
+        "/clubs/{id}/moderators": {
+            "get": {
+                "description": "Handler for getting club moderators list",
                 "consumes": [
                     "application/json"
                 ],
@@ -498,7 +1171,7 @@ const docTemplate = `{
                 "tags": [
                     "Clubs"
                 ],
-                "summary": "delete club",
+                "summary": "get club moderators",
                 "parameters": [
                     {
                         "type": "integer",
@@ -506,26 +1179,44 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "Limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": ""
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.Error"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.Error"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.UserCard"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.Error"
                         }
@@ -539,9 +1230,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/clubs/{id}/events": {
-            "get": {
-                "description": "Handler for getting tags list",
+        "/clubs/{id}/moderators/{uid}": {
+            "post": {
+                "description": "Handler for making a club participant a moderator. Only the club admin can do it",
                 "consumes": [
                     "application/json"
                 ],
@@ -551,7 +1242,7 @@ const docTemplate = `{
                 "tags": [
                     "Clubs"
                 ],
-                "summary": "get clubs events list",
+                "summary": "promote moderator",
                 "parameters": [
                     {
                         "type": "integer",
@@ -562,32 +1253,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "IdGt",
-                        "name": "IdGt",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "IdLte",
-                        "name": "IdLte",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "Limit",
-                        "in": "query"
+                        "description": "User ID",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.EventCard"
-                            }
-                        }
+                        "description": ""
                     },
                     "400": {
                         "description": "Bad Request",
@@ -595,6 +1269,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -608,11 +1294,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/clubs/{id}/leave": {
-            "post": {
-                "description": "Handler for leaving club",
+            },
+            "delete": {
+                "description": "Handler for making a club moderator a regular participant again. Only the club admin can do it",
                 "consumes": [
                     "application/json"
                 ],
@@ -622,7 +1306,7 @@ const docTemplate = `{
                 "tags": [
                     "Clubs"
                 ],
-                "summary": "leave club",
+                "summary": "demote moderator",
                 "parameters": [
                     {
                         "type": "integer",
@@ -630,6 +1314,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -643,7 +1334,16 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": ""
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -702,6 +1402,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -739,15 +1451,9 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "IdGt",
-                        "name": "IdGt",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "IdLte",
-                        "name": "IdLte",
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
                         "in": "query"
                     },
                     {
@@ -773,10 +1479,22 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.UserCard"
-                            }
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.UserCard"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -785,6 +1503,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -842,7 +1572,10 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": ""
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -881,6 +1614,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/models.CreateEventRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the first response to a retry with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -896,6 +1635,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -933,15 +1684,9 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "IdGt",
-                        "name": "IdGt",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "IdLte",
-                        "name": "IdLte",
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
                         "in": "query"
                     },
                     {
@@ -955,10 +1700,22 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.EventPost"
-                            }
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.EventPost"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1011,6 +1768,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/models.CreatePostRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the first response to a retry with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -1026,6 +1789,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1141,6 +1916,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1171,15 +1952,9 @@ const docTemplate = `{
                 "summary": "get events list",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "IdGt",
-                        "name": "IdGt",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "IdLte",
-                        "name": "IdLte",
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
                         "in": "query"
                     },
                     {
@@ -1221,12 +1996,24 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.EventCard"
-                            }
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.EventCard"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1301,7 +2088,16 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": ""
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -1404,7 +2200,16 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": ""
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -1521,6 +2326,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1569,7 +2380,10 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": ""
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -1628,6 +2442,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1665,15 +2491,9 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "IdGt",
-                        "name": "IdGt",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "IdLte",
-                        "name": "IdLte",
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
                         "in": "query"
                     },
                     {
@@ -1699,10 +2519,22 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.UserCard"
-                            }
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.UserCard"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1711,6 +2543,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1768,7 +2612,10 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": ""
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -1820,6 +2667,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1871,7 +2730,10 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": ""
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -1921,7 +2783,193 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": ""
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/garage/{id}/delete": {
+            "post": {
+                "description": "Handler for deleting car",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "delete car",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Car ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/garage/{id}/upload": {
+            "post": {
+                "description": "Handler for creating an event",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "upload avatar for car",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Car ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image to upload",
+                        "name": "file-upload",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "Handler for signing up new user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "login user",
+                "parameters": [
+                    {
+                        "description": "User",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AuthResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1935,6 +2983,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1950,9 +3004,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/garage/{id}/delete": {
+        "/logout": {
             "post": {
-                "description": "Handler for deleting car",
+                "description": "Handler for deleting the current session",
                 "consumes": [
                     "application/json"
                 ],
@@ -1962,38 +3016,17 @@ const docTemplate = `{
                 "tags": [
                     "Users"
                 ],
-                "summary": "delete car",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Car ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "logout user",
                 "responses": {
                     "200": {
                         "description": ""
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.Error"
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2003,11 +3036,11 @@ const docTemplate = `{
                 }
             }
         },
-        "/garage/{id}/upload": {
-            "post": {
-                "description": "Handler for creating an event",
+        "/me": {
+            "get": {
+                "description": "Handler for getting a user by id",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
@@ -2015,23 +3048,7 @@ const docTemplate = `{
                 "tags": [
                     "Users"
                 ],
-                "summary": "upload avatar for car",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Car ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Image to upload",
-                        "name": "file-upload",
-                        "in": "formData",
-                        "required": true
-                    }
-                ],
+                "summary": "get user by id",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2045,6 +3062,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -2060,9 +3083,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/login": {
-            "post": {
-                "description": "Handler for signing up new user",
+        "/me/sessions": {
+            "get": {
+                "description": "Handler for listing active sessions of the current user",
                 "consumes": [
                     "application/json"
                 ],
@@ -2072,36 +3095,49 @@ const docTemplate = `{
                 "tags": [
                     "Users"
                 ],
-                "summary": "login user",
-                "parameters": [
-                    {
-                        "description": "User",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.LoginRequest"
-                        }
-                    }
-                ],
+                "summary": "get active sessions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Session"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SessionInfo"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
-                    "401": {
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Handler for revoking all sessions of the current user, including the current one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "logout everywhere",
+                "responses": {
+                    "200": {
                         "description": ""
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/utils.Error"
                         }
@@ -2115,9 +3151,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/me": {
-            "get": {
-                "description": "Handler for getting a user by id",
+        "/me/sessions/{id}": {
+            "delete": {
+                "description": "Handler for revoking one of the current user's sessions",
                 "consumes": [
                     "application/json"
                 ],
@@ -2127,16 +3163,22 @@ const docTemplate = `{
                 "tags": [
                     "Users"
                 ],
-                "summary": "get user by id",
+                "summary": "revoke session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/models.User"
-                        }
+                        "description": ""
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/utils.Error"
                         }
@@ -2193,6 +3235,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -2230,6 +3278,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/models.CreateMiniEventRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the first response to a retry with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -2245,6 +3299,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -2275,15 +3335,9 @@ const docTemplate = `{
                 "summary": "get mini events list",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "IdGt",
-                        "name": "IdGt",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "IdLte",
-                        "name": "IdLte",
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
                         "in": "query"
                     },
                     {
@@ -2297,10 +3351,22 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.MiniEvent"
-                            }
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.MiniEvent"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -2396,6 +3462,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/models.CarRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the first response to a retry with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -2412,7 +3484,10 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": ""
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -2429,6 +3504,58 @@ const docTemplate = `{
                 }
             }
         },
+        "/session/refresh": {
+            "post": {
+                "description": "Handler for exchanging a refresh token for a new session and refresh token. Every refresh token can be used once, reusing it revokes all sessions issued from the same login",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "refresh session",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RefreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    }
+                }
+            }
+        },
         "/signup": {
             "post": {
                 "description": "Handler for signing up new user",
@@ -2466,6 +3593,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -2496,15 +3629,9 @@ const docTemplate = `{
                 "summary": "get clubs where user is owner",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "IdGt",
-                        "name": "IdGt",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "IdLte",
-                        "name": "IdLte",
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
                         "in": "query"
                     },
                     {
@@ -2518,10 +3645,22 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.ClubCard"
-                            }
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.ClubCard"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -2531,7 +3670,10 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": ""
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -2620,15 +3762,9 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "IdGt",
-                        "name": "IdGt",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "IdLte",
-                        "name": "IdLte",
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
                         "in": "query"
                     },
                     {
@@ -2654,10 +3790,22 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.ClubCard"
-                            }
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.ClubCard"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -2667,7 +3815,10 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": ""
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -2768,15 +3919,9 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "IdGt",
-                        "name": "IdGt",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "IdLte",
-                        "name": "IdLte",
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
                         "in": "query"
                     },
                     {
@@ -2800,12 +3945,24 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.EventCard"
-                            }
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.EventCard"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -2815,7 +3972,10 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": ""
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -2854,15 +4014,9 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "IdGt",
-                        "name": "IdGt",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "IdLte",
-                        "name": "IdLte",
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "Cursor",
                         "in": "query"
                     },
                     {
@@ -2876,10 +4030,22 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.CarCard"
-                            }
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/pagination.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.CarCard"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -2889,7 +4055,10 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": ""
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.Error"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -2908,6 +4077,109 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "apperrors.FieldError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "field": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AdminComplaint": {
+            "type": "object",
+            "required": [
+                "author",
+                "created_at",
+                "id",
+                "status",
+                "target_id",
+                "target_type",
+                "text"
+            ],
+            "properties": {
+                "author": {
+                    "$ref": "#/definitions/models.UserCard"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "resolution": {
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "resolved_by": {
+                    "$ref": "#/definitions/models.UserCard"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "target_id": {
+                    "type": "integer"
+                },
+                "target_type": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AuthResponse": {
+            "type": "object",
+            "required": [
+                "created_at",
+                "expires_at",
+                "id",
+                "last_seen_at",
+                "refresh_token",
+                "refresh_token_expires_at",
+                "user_id",
+                "value"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "family_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "last_seen_at": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "refresh_token_expires_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
         "models.CarCard": {
             "type": "object",
             "required": [
@@ -3011,6 +4283,7 @@ const docTemplate = `{
                 "participants_count",
                 "subscribers_count",
                 "tags",
+                "updated_at",
                 "user_status"
             ],
             "properties": {
@@ -3044,6 +4317,9 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "updated_at": {
+                    "type": "string"
+                },
                 "user_status": {
                     "type": "string"
                 }
@@ -3083,6 +4359,79 @@ const docTemplate = `{
                 }
             }
         },
+        "models.ComplaintCard": {
+            "type": "object",
+            "required": [
+                "id",
+                "target_id",
+                "target_type",
+                "text",
+                "user_id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "target_id": {
+                    "type": "integer"
+                },
+                "target_type": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ComplaintDetails": {
+            "type": "object",
+            "required": [
+                "complaint",
+                "history"
+            ],
+            "properties": {
+                "complaint": {
+                    "$ref": "#/definitions/models.AdminComplaint"
+                },
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ComplaintHistoryEntry"
+                    }
+                },
+                "target": {}
+            }
+        },
+        "models.ComplaintHistoryEntry": {
+            "type": "object",
+            "required": [
+                "action",
+                "comment",
+                "created_at",
+                "handled_by",
+                "id"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "handled_by": {
+                    "$ref": "#/definitions/models.UserCard"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
         "models.ComplaintReq": {
             "type": "object",
             "properties": {
@@ -3204,6 +4553,7 @@ const docTemplate = `{
                 "name",
                 "participants_count",
                 "spectators_count",
+                "updated_at",
                 "user_status"
             ],
             "properties": {
@@ -3240,6 +4590,9 @@ const docTemplate = `{
                 "spectators_count": {
                     "type": "integer"
                 },
+                "updated_at": {
+                    "type": "string"
+                },
                 "user_status": {
                     "type": "string"
                 }
@@ -3321,8 +4674,8 @@ const docTemplate = `{
         "models.LoginRequest": {
             "type": "object",
             "properties": {
-                "vkid": {
-                    "type": "integer"
+                "launchParams": {
+                    "type": "string"
                 }
             }
         },
@@ -3384,17 +4737,63 @@ const docTemplate = `{
                 }
             }
         },
+        "models.RefreshRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ResolveComplaintRequest": {
+            "type": "object",
+            "required": [
+                "action"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                }
+            }
+        },
         "models.Session": {
             "type": "object",
             "required": [
+                "created_at",
                 "expires_at",
+                "id",
+                "last_seen_at",
                 "user_id",
                 "value"
             ],
             "properties": {
+                "created_at": {
+                    "type": "string"
+                },
                 "expires_at": {
                     "type": "string"
                 },
+                "family_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "last_seen_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
+                },
                 "user_id": {
                     "type": "integer"
                 },
@@ -3403,6 +4802,41 @@ const docTemplate = `{
                 }
             }
         },
+        "models.SessionInfo": {
+            "type": "object",
+            "required": [
+                "created_at",
+                "current",
+                "expires_at",
+                "id",
+                "ip",
+                "last_seen_at",
+                "user_agent"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "current": {
+                    "type": "boolean"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "last_seen_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
+                }
+            }
+        },
         "models.SignUpRequest": {
             "type": "object",
             "properties": {
@@ -3418,6 +4852,9 @@ const docTemplate = `{
                         "$ref": "#/definitions/models.CarRequest"
                     }
                 },
+                "launchParams": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -3429,9 +4866,6 @@ const docTemplate = `{
                     "items": {
                         "type": "string"
                     }
-                },
-                "vkid": {
-                    "type": "integer"
                 }
             }
         },
@@ -3439,12 +4873,20 @@ const docTemplate = `{
             "type": "object",
             "required": [
                 "car_id",
+                "refresh_token",
+                "refresh_token_expires_at",
                 "session"
             ],
             "properties": {
                 "car_id": {
                     "type": "integer"
                 },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "refresh_token_expires_at": {
+                    "type": "string"
+                },
                 "session": {
                     "$ref": "#/definitions/models.Session"
                 }
@@ -3465,6 +4907,23 @@ const docTemplate = `{
                 }
             }
         },
+        "models.UpdateClubRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "models.UpdateRequest": {
             "type": "object",
             "properties": {
@@ -3487,6 +4946,7 @@ const docTemplate = `{
                 "name",
                 "surname",
                 "tags",
+                "updated_at",
                 "vkid"
             ],
             "properties": {
@@ -3508,6 +4968,9 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "updated_at": {
+                    "type": "string"
+                },
                 "vkid": {
                     "type": "integer"
                 }
@@ -3536,9 +4999,30 @@ const docTemplate = `{
                 }
             }
         },
+        "pagination.Response": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean"
+                },
+                "items": {},
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
         "utils.Error": {
             "type": "object",
             "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/apperrors.FieldError"
+                    }
+                },
                 "message": {
                     "type": "string"
                 }
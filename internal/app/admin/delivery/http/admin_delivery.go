@@ -0,0 +1,192 @@
+package delivery
+
+import (
+	"encoding/json"
+	"github.com/dantedoyl/car-life-api/internal/app/admin"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+	"github.com/dantedoyl/car-life-api/internal/app/middleware"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+	"github.com/dantedoyl/car-life-api/internal/app/utils"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/schema"
+	"net/http"
+	"strconv"
+)
+
+// AdminHandler serves the platform moderation API. It is available only to
+// platform staff; staff accounts are granted with UPDATE users SET role = 'staff'.
+type AdminHandler struct {
+	adminUcase admin.IAdminUsecase
+}
+
+func NewAdminHandler(adminUcase admin.IAdminUsecase) *AdminHandler {
+	return &AdminHandler{
+		adminUcase: adminUcase,
+	}
+}
+
+func (ah *AdminHandler) Configure(r *mux.Router, mw *middleware.Middleware) {
+	r.HandleFunc("/admin/complaints", mw.CheckAuthMiddleware(mw.RequirePlatformPermission(authorization.ActionPlatformModerate, ah.GetComplaints))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/admin/complaints/{id:[0-9]+}", mw.CheckAuthMiddleware(mw.RequirePlatformPermission(authorization.ActionPlatformModerate, ah.GetComplaintByID))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/admin/complaints/{id:[0-9]+}/resolve", mw.CheckAuthMiddleware(mw.RequirePlatformPermission(authorization.ActionPlatformModerate, ah.ResolveComplaint))).Methods(http.MethodPost, http.MethodOptions)
+}
+
+func (ah *AdminHandler) ConfigureV2(r *mux.Router, mw *middleware.Middleware) {
+	r.HandleFunc("/admin/complaints", mw.CheckAuthMiddleware(mw.RequirePlatformPermission(authorization.ActionPlatformModerate, ah.GetComplaints))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/admin/complaints/{id:[0-9]+}", mw.CheckAuthMiddleware(mw.RequirePlatformPermission(authorization.ActionPlatformModerate, ah.GetComplaintByID))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/admin/complaints/{id:[0-9]+}", mw.CheckAuthMiddleware(mw.RequirePlatformPermission(authorization.ActionPlatformModerate, ah.ResolveComplaint))).Methods(http.MethodPatch, http.MethodOptions)
+}
+
+var (
+	complaintTargetTypes = map[string]bool{"club": true, "event": true, "post": true, "car": true, "user": true}
+	complaintStatuses    = map[string]bool{"open": true, "resolved": true, "dismissed": true}
+)
+
+// GetComplaints godoc
+// @Summary      get complaints
+// @Description  Handler for getting complaints across the platform. Available to platform staff only
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        TargetType query string false "club, event, post, car or user"
+// @Param        Status query string false "open, resolved or dismissed"
+// @Param        Cursor query string false "Cursor"
+// @Param        Limit query integer false "Limit"
+// @Success      200  {object}  pagination.Response{items=[]models.AdminComplaint}
+// @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /admin/complaints [get]
+func (ah *AdminHandler) GetComplaints(w http.ResponseWriter, r *http.Request) {
+	query := &models.AdminComplaintQuery{}
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	err := decoder.Decode(query, r.URL.Query())
+	if err != nil {
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
+		return
+	}
+
+	if query.TargetType != nil && !complaintTargetTypes[*query.TargetType] {
+		utils.WriteError(w, r, apperrors.Validation("unknown target type"))
+		return
+	}
+
+	if query.Status != nil && !complaintStatuses[*query.Status] {
+		utils.WriteError(w, r, apperrors.Validation("unknown status"))
+		return
+	}
+
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	complaints, next, err := ah.adminUcase.GetComplaints(query.TargetType, query.Status, page)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+	if len(complaints) == 0 {
+		complaints = []*models.AdminComplaint{}
+	}
+
+	body, err := json.Marshal(pagination.NewResponse(complaints, next))
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// GetComplaintByID godoc
+// @Summary      get complaint
+// @Description  Handler for getting a complaint with the object it is about and the moderation history. Available to platform staff only
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id path int64 true "Complaint ID"
+// @Success      200  {object}  models.ComplaintDetails
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
+// @Failure      404  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /admin/complaints/{id} [get]
+func (ah *AdminHandler) GetComplaintByID(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	complaintID, _ := strconv.ParseUint(vars["id"], 10, 64)
+	userID := r.Context().Value("userID").(uint64)
+
+	details, err := ah.adminUcase.GetComplaintDetails(complaintID, userID)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	body, err := json.Marshal(details)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// ResolveComplaint godoc
+// @Summary      resolve complaint
+// @Description  Handler for resolving an open complaint. Action is one of dismiss, hide, delete or ban; ban bans the author of the reported object. Available to platform staff only
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id path int64 true "Complaint ID"
+// @Param        body body models.ResolveComplaintRequest true "Resolution"
+// @Success      200
+// @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
+// @Failure      404  {object}  utils.Error
+// @Failure      409  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /admin/complaints/{id}/resolve [post]
+func (ah *AdminHandler) ResolveComplaint(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	userID, ok := r.Context().Value("userID").(uint64)
+	if !ok {
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+		return
+	}
+
+	vars := mux.Vars(r)
+	complaintID, _ := strconv.ParseUint(vars["id"], 10, 64)
+
+	req := &models.ResolveComplaintRequest{}
+	err := json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
+		return
+	}
+
+	err = req.Validate()
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	err = ah.adminUcase.ResolveComplaint(complaintID, userID, req.Action, req.Comment)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
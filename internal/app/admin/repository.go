@@ -0,0 +1,13 @@
+package admin
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+)
+
+type IAdminRepository interface {
+	SelectComplaints(targetType *string, status *string, page pagination.Page) ([]*models.AdminComplaint, *pagination.Cursor, error)
+	SelectComplaintByID(complaintID uint64) (*models.AdminComplaint, error)
+	SelectComplaintHistory(complaintID uint64) ([]*models.ComplaintHistoryEntry, error)
+	ResolveComplaint(complaintID uint64, staffID uint64, status string, action string, comment string) (uint64, error)
+}
@@ -0,0 +1,263 @@
+package admin_repository
+
+import (
+	"database/sql"
+	"github.com/dantedoyl/car-life-api/internal/app/admin"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+	"strconv"
+)
+
+type AdminRepository struct {
+	dbConn *sql.DB
+}
+
+func NewAdminRepository(conn *sql.DB) admin.IAdminRepository {
+	return &AdminRepository{
+		dbConn: conn,
+	}
+}
+
+const selectComplaints = `SELECT c.id, c.target_type, c.target_id, c.user_id, u.name, u.surname, u.avatar, coalesce(c.text, ''), c.status, c.created_at,
+			c.resolved_by, r.name, r.surname, r.avatar, c.resolved_at, c.resolution from complaints as c
+			INNER JOIN users as u on u.vk_id = c.user_id
+			LEFT JOIN users as r on r.vk_id = c.resolved_by`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanComplaint(row rowScanner) (*models.AdminComplaint, error) {
+	complaint := &models.AdminComplaint{}
+	var resolvedBy sql.NullInt64
+	var resolverName, resolverSurname, resolverAvatar, resolution sql.NullString
+	var resolvedAt sql.NullTime
+	err := row.Scan(&complaint.ID, &complaint.TargetType, &complaint.TargetID,
+		&complaint.Author.VKID, &complaint.Author.Name, &complaint.Author.Surname, &complaint.Author.AvatarUrl,
+		&complaint.Text, &complaint.Status, &complaint.CreatedAt,
+		&resolvedBy, &resolverName, &resolverSurname, &resolverAvatar, &resolvedAt, &resolution)
+	if err != nil {
+		return nil, err
+	}
+
+	if resolvedBy.Valid {
+		complaint.ResolvedBy = &models.UserCard{
+			VKID:      uint64(resolvedBy.Int64),
+			Name:      resolverName.String,
+			Surname:   resolverSurname.String,
+			AvatarUrl: resolverAvatar.String,
+		}
+	}
+	if resolvedAt.Valid {
+		complaint.ResolvedAt = &resolvedAt.Time
+	}
+	complaint.Resolution = resolution.String
+
+	return complaint, nil
+}
+
+func (ar *AdminRepository) SelectComplaints(targetType *string, status *string, page pagination.Page) ([]*models.AdminComplaint, *pagination.Cursor, error) {
+	var complaints []*models.AdminComplaint
+	ind := 1
+	var values []interface{}
+	q := selectComplaints + ` WHERE true`
+
+	if targetType != nil {
+		q += ` AND c.target_type = $` + strconv.Itoa(ind)
+		values = append(values, *targetType)
+		ind++
+	}
+
+	if status != nil {
+		q += ` AND c.status = $` + strconv.Itoa(ind)
+		values = append(values, *status)
+		ind++
+	}
+
+	if page.After != nil {
+		q += ` AND c.id > $` + strconv.Itoa(ind)
+		values = append(values, page.After.ID)
+		ind++
+	}
+
+	q += ` ORDER BY c.id LIMIT $` + strconv.Itoa(ind)
+	values = append(values, page.FetchLimit())
+	rows, err := ar.dbConn.Query(q, values...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer rows.Close()
+
+	var last, next *pagination.Cursor
+	for rows.Next() {
+		complaint, err := scanComplaint(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		if page.Full(len(complaints)) {
+			next = last
+			break
+		}
+		complaints = append(complaints, complaint)
+		last = &pagination.Cursor{ID: complaint.ID}
+	}
+	return complaints, next, rows.Err()
+}
+
+func (ar *AdminRepository) SelectComplaintByID(complaintID uint64) (*models.AdminComplaint, error) {
+	complaint, err := scanComplaint(ar.dbConn.QueryRow(selectComplaints+` WHERE c.id = $1`, complaintID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return complaint, nil
+}
+
+func (ar *AdminRepository) SelectComplaintHistory(complaintID uint64) ([]*models.ComplaintHistoryEntry, error) {
+	var history []*models.ComplaintHistoryEntry
+	rows, err := ar.dbConn.Query(
+		`SELECT h.id, h.action, coalesce(h.comment, ''), h.created_at, u.vk_id, u.name, u.surname, u.avatar from complaints_history as h
+				INNER JOIN users as u on u.vk_id = h.handled_by
+				WHERE h.complaint_id = $1 ORDER BY h.id`, complaintID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		entry := &models.ComplaintHistoryEntry{}
+		err = rows.Scan(&entry.ID, &entry.Action, &entry.Comment, &entry.CreatedAt,
+			&entry.HandledBy.VKID, &entry.HandledBy.Name, &entry.HandledBy.Surname, &entry.HandledBy.AvatarUrl)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, entry)
+	}
+	return history, nil
+}
+
+// ResolveComplaint locks the complaint, applies the action to its target and closes it
+// in one transaction, so that the complaint is resolved once and an action is never
+// applied without being recorded. For a ban it returns the id of the banned user.
+func (ar *AdminRepository) ResolveComplaint(complaintID uint64, staffID uint64, status string, action string, comment string) (uint64, error) {
+	tx, err := ar.dbConn.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var targetType, currentStatus string
+	var targetID uint64
+	err = tx.QueryRow(`SELECT target_type, target_id, status FROM complaints WHERE id = $1 FOR UPDATE`, complaintID).
+		Scan(&targetType, &targetID, &currentStatus)
+	if err == sql.ErrNoRows {
+		return 0, admin.ErrComplaintNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	if currentStatus != "open" {
+		return 0, admin.ErrComplaintClosed
+	}
+
+	var bannedID uint64
+	switch action {
+	case admin.ActionHide:
+		err = hideTarget(tx, targetType, targetID)
+	case admin.ActionDelete:
+		err = deleteTarget(tx, targetType, targetID)
+	case admin.ActionBan:
+		bannedID, err = banTargetOwner(tx, targetType, targetID)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(
+		`UPDATE complaints SET status = $3, resolved_by = $2, resolved_at = now(), resolution = $4 WHERE id = $1`,
+		complaintID, staffID, status, action)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(
+		`INSERT INTO complaints_history (complaint_id, handled_by, action, comment) VALUES ($1, $2, $3, $4)`,
+		complaintID, staffID, action, comment)
+	if err != nil {
+		return 0, err
+	}
+
+	return bannedID, tx.Commit()
+}
+
+// targetTables maps complaint target types to the tables that can be hidden or deleted.
+var targetTables = map[string]string{
+	"club":  "clubs",
+	"event": "events",
+	"post":  "events_posts",
+	"car":   "cars",
+}
+
+func hideTarget(tx *sql.Tx, targetType string, targetID uint64) error {
+	table, ok := targetTables[targetType]
+	if !ok {
+		return admin.ErrActionNotSupported
+	}
+
+	var id uint64
+	err := tx.QueryRow(`UPDATE `+table+` SET hidden = true WHERE id = $1 RETURNING id`, targetID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return admin.ErrTargetNotFound
+	}
+	return err
+}
+
+func deleteTarget(tx *sql.Tx, targetType string, targetID uint64) error {
+	table, ok := targetTables[targetType]
+	if !ok {
+		return admin.ErrActionNotSupported
+	}
+
+	var id uint64
+	err := tx.QueryRow(`DELETE FROM `+table+` WHERE id = $1 RETURNING id`, targetID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return admin.ErrTargetNotFound
+	}
+	return err
+}
+
+var targetOwnerQueries = map[string]string{
+	"user":  `SELECT vk_id FROM users WHERE vk_id = $1`,
+	"car":   `SELECT owner_id FROM cars WHERE id = $1`,
+	"post":  `SELECT user_id FROM events_posts WHERE id = $1`,
+	"event": `SELECT creator_id FROM events WHERE id = $1`,
+	"club":  `SELECT user_id FROM users_clubs WHERE club_id = $1 and status = 'admin'`,
+}
+
+// banTargetOwner bans the author of the target: the club admin for clubs,
+// the user itself for user complaints.
+func banTargetOwner(tx *sql.Tx, targetType string, targetID uint64) (uint64, error) {
+	query, ok := targetOwnerQueries[targetType]
+	if !ok {
+		return 0, admin.ErrActionNotSupported
+	}
+
+	var ownerID uint64
+	err := tx.QueryRow(query, targetID).Scan(&ownerID)
+	if err == sql.ErrNoRows {
+		return 0, admin.ErrTargetNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(`UPDATE users SET banned = true WHERE vk_id = $1`, ownerID)
+	if err != nil {
+		return 0, err
+	}
+	return ownerID, nil
+}
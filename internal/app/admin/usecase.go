@@ -0,0 +1,28 @@
+package admin
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+)
+
+var (
+	ErrComplaintNotFound  = apperrors.NotFound("complaint not found")
+	ErrComplaintClosed    = apperrors.Conflict("complaint is already closed")
+	ErrTargetNotFound     = apperrors.NotFound("complaint target not found")
+	ErrUnknownAction      = apperrors.Validation("unknown action")
+	ErrActionNotSupported = apperrors.Validation("action is not supported for this target type")
+)
+
+const (
+	ActionDismiss = "dismiss"
+	ActionHide    = "hide"
+	ActionDelete  = "delete"
+	ActionBan     = "ban"
+)
+
+type IAdminUsecase interface {
+	GetComplaints(targetType *string, status *string, page pagination.Page) ([]*models.AdminComplaint, *pagination.Cursor, error)
+	GetComplaintDetails(complaintID uint64, staffID uint64) (*models.ComplaintDetails, error)
+	ResolveComplaint(complaintID uint64, staffID uint64, action string, comment string) error
+}
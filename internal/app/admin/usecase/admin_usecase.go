@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"errors"
+	"github.com/dantedoyl/car-life-api/internal/app/admin"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	clubs "github.com/dantedoyl/car-life-api/internal/app/clubs"
+	"github.com/dantedoyl/car-life-api/internal/app/events"
+	"github.com/dantedoyl/car-life-api/internal/app/events_posts"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+	"github.com/dantedoyl/car-life-api/internal/app/users"
+)
+
+type AdminUsecase struct {
+	adminRepo   admin.IAdminRepository
+	usersUcase  users.IUsersUsecase
+	clubsUcase  clubs.IClubsUsecase
+	eventsUcase events.IEventsUsecase
+	postsUcase  events_posts.IEventsPostsUsecase
+}
+
+func NewAdminUsecase(repo admin.IAdminRepository, usersUcase users.IUsersUsecase, clubsUcase clubs.IClubsUsecase,
+	eventsUcase events.IEventsUsecase, postsUcase events_posts.IEventsPostsUsecase) admin.IAdminUsecase {
+	return &AdminUsecase{
+		adminRepo:   repo,
+		usersUcase:  usersUcase,
+		clubsUcase:  clubsUcase,
+		eventsUcase: eventsUcase,
+		postsUcase:  postsUcase,
+	}
+}
+
+func (au *AdminUsecase) GetComplaints(targetType *string, status *string, page pagination.Page) ([]*models.AdminComplaint, *pagination.Cursor, error) {
+	return au.adminRepo.SelectComplaints(targetType, status, page)
+}
+
+func (au *AdminUsecase) GetComplaintDetails(complaintID uint64, staffID uint64) (*models.ComplaintDetails, error) {
+	complaint, err := au.adminRepo.SelectComplaintByID(complaintID)
+	if err != nil {
+		return nil, err
+	}
+	if complaint == nil {
+		return nil, admin.ErrComplaintNotFound
+	}
+
+	target, err := au.getTarget(complaint.TargetType, complaint.TargetID, staffID)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := au.adminRepo.SelectComplaintHistory(complaintID)
+	if err != nil {
+		return nil, err
+	}
+	if len(history) == 0 {
+		history = []*models.ComplaintHistoryEntry{}
+	}
+
+	return &models.ComplaintDetails{
+		Complaint: complaint,
+		Target:    target,
+		History:   history,
+	}, nil
+}
+
+// getTarget loads the object the complaint is about on behalf of the staff member,
+// so that it is found even if it has been hidden.
+func (au *AdminUsecase) getTarget(targetType string, targetID uint64, staffID uint64) (interface{}, error) {
+	var target interface{}
+	var err error
+	switch targetType {
+	case "club":
+		target, err = au.clubsUcase.GetClubByID(targetID, staffID)
+	case "event":
+		target, err = au.eventsUcase.GetEventByID(targetID, staffID)
+	case "post":
+		target, err = au.postsUcase.GetEventPostByPostID(targetID, staffID)
+	case "car":
+		target, err = au.usersUcase.SelectCarByID(int64(targetID), staffID)
+	case "user":
+		target, err = au.usersUcase.GetByID(targetID)
+	default:
+		return nil, nil
+	}
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// ResolveComplaint applies the action and closes the complaint in one transaction.
+// A banned user is logged out after that, since the sessions live outside of Postgres;
+// if it fails the ban stands and keeps the user from logging in again.
+func (au *AdminUsecase) ResolveComplaint(complaintID uint64, staffID uint64, action string, comment string) error {
+	status := "resolved"
+	switch action {
+	case admin.ActionDismiss:
+		status = "dismissed"
+	case admin.ActionHide, admin.ActionDelete, admin.ActionBan:
+	default:
+		return admin.ErrUnknownAction
+	}
+
+	bannedID, err := au.adminRepo.ResolveComplaint(complaintID, staffID, status, action, comment)
+	if err != nil {
+		return err
+	}
+	if bannedID == 0 {
+		return nil
+	}
+
+	return au.usersUcase.DeleteAllUserSessions(bannedID)
+}
@@ -0,0 +1,93 @@
+// Package apperrors contains the errors repositories and usecases return to tell
+// the delivery layer what went wrong. utils.WriteError turns them into HTTP responses.
+package apperrors
+
+import "errors"
+
+type Code string
+
+const (
+	CodeValidation   Code = "validation_error"
+	CodeUnauthorized Code = "unauthorized"
+	CodeForbidden    Code = "forbidden"
+	CodeNotFound     Code = "not_found"
+	CodeConflict     Code = "conflict"
+	CodeRateLimited  Code = "rate_limited"
+	CodeInternal     Code = "internal_error"
+)
+
+type Error struct {
+	Code    Code
+	Message string
+	// Fields lists the invalid request fields of a validation error.
+	Fields []FieldError
+}
+
+type FieldError struct {
+	Field string `json:"field"`
+	Error string `json:"error"`
+}
+
+// Generic errors of every kind. errors.Is(err, apperrors.ErrNotFound) is true for
+// any not found error, while errors.Is(err, clubs.ErrSomething) matches only that error.
+var (
+	ErrValidation   = &Error{Code: CodeValidation}
+	ErrUnauthorized = &Error{Code: CodeUnauthorized}
+	ErrForbidden    = &Error{Code: CodeForbidden}
+	ErrNotFound     = &Error{Code: CodeNotFound}
+	ErrConflict     = &Error{Code: CodeConflict}
+)
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return string(e.Code)
+	}
+	return e.Message
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	return t.Code == e.Code && (t.Message == "" || t.Message == e.Message)
+}
+
+func Validation(message string) error {
+	return &Error{Code: CodeValidation, Message: message}
+}
+
+// InvalidFields is a validation error that lists every invalid field of the request.
+func InvalidFields(fields []FieldError) error {
+	return &Error{Code: CodeValidation, Message: "request is invalid", Fields: fields}
+}
+
+func Unauthorized(message string) error {
+	return &Error{Code: CodeUnauthorized, Message: message}
+}
+
+func Forbidden(message string) error {
+	return &Error{Code: CodeForbidden, Message: message}
+}
+
+func NotFound(message string) error {
+	return &Error{Code: CodeNotFound, Message: message}
+}
+
+func Conflict(message string) error {
+	return &Error{Code: CodeConflict, Message: message}
+}
+
+// RateLimited tells the client to slow down. The delivery layer adds the Retry-After header.
+func RateLimited(message string) error {
+	return &Error{Code: CodeRateLimited, Message: message}
+}
+
+// CodeOf returns the code of err, or CodeInternal if err is not an *Error.
+func CodeOf(err error) Code {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Code
+	}
+	return CodeInternal
+}
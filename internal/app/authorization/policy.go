@@ -0,0 +1,119 @@
+package authorization
+
+import "github.com/dantedoyl/car-life-api/internal/app/apperrors"
+
+var (
+	ErrForbidden        = apperrors.Forbidden("user has no permission for this action")
+	ErrResourceNotFound = apperrors.NotFound("resource not found")
+)
+
+type Action string
+
+const (
+	ActionClubUpdate              Action = "club:update"
+	ActionClubDelete              Action = "club:delete"
+	ActionClubViewRequests        Action = "club:view_requests"
+	ActionClubManageParticipants  Action = "club:manage_participants"
+	ActionClubViewChat            Action = "club:view_chat"
+	ActionClubCreateEvent         Action = "club:create_event"
+	ActionClubManageModerators    Action = "club:manage_moderators"
+	ActionClubHandleComplaints    Action = "club:handle_complaints"
+	ActionEventUpdate             Action = "event:update"
+	ActionEventDelete             Action = "event:delete"
+	ActionEventViewRequests       Action = "event:view_requests"
+	ActionEventManageParticipants Action = "event:manage_participants"
+	ActionEventViewChat           Action = "event:view_chat"
+	ActionEventCreatePost         Action = "event:create_post"
+	ActionPostUpdate              Action = "post:update"
+	ActionPostDelete              Action = "post:delete"
+	ActionCarUpdate               Action = "car:update"
+	ActionCarDelete               Action = "car:delete"
+	ActionPlatformModerate        Action = "platform:moderate"
+)
+
+type ResourceType string
+
+const (
+	ResourceClub  ResourceType = "club"
+	ResourceEvent ResourceType = "event"
+	ResourcePost  ResourceType = "post"
+	ResourceCar   ResourceType = "car"
+	// ResourcePlatform is the application itself, actions on it ignore the resource id.
+	ResourcePlatform ResourceType = "platform"
+)
+
+// Role is what a user is to a resource. Roles come from users_clubs/users_events
+// statuses and from ownership, and are inherited downwards: an event carries
+// the roles of its club, a post carries the roles of its event.
+type Role string
+
+const (
+	RoleClubAdmin        Role = "club:admin"
+	RoleClubParticipant  Role = "club:participant"
+	RoleClubModerator    Role = "club:moderator"
+	RoleEventAdmin       Role = "event:admin"
+	RoleEventParticipant Role = "event:participant"
+	RolePostAuthor       Role = "post:author"
+	RoleCarOwner         Role = "car:owner"
+	RolePlatformStaff    Role = "platform:staff"
+)
+
+func ClubRole(status string) Role {
+	return Role("club:" + status)
+}
+
+func EventRole(status string) Role {
+	return Role("event:" + status)
+}
+
+func PlatformRole(role string) Role {
+	return Role("platform:" + role)
+}
+
+// Rule allows an action on a resource of the given type to anyone holding one of the roles.
+type Rule struct {
+	Resource ResourceType
+	Roles    []Role
+}
+
+// Policy is the single place where permissions are defined.
+// An action that is missing from the table is denied.
+var Policy = map[Action]Rule{
+	ActionClubUpdate:              {Resource: ResourceClub, Roles: []Role{RoleClubAdmin}},
+	ActionClubDelete:              {Resource: ResourceClub, Roles: []Role{RoleClubAdmin}},
+	ActionClubViewRequests:        {Resource: ResourceClub, Roles: []Role{RoleClubAdmin, RoleClubModerator}},
+	ActionClubManageParticipants:  {Resource: ResourceClub, Roles: []Role{RoleClubAdmin, RoleClubModerator}},
+	ActionClubViewChat:            {Resource: ResourceClub, Roles: []Role{RoleClubAdmin, RoleClubModerator, RoleClubParticipant}},
+	ActionClubCreateEvent:         {Resource: ResourceClub, Roles: []Role{RoleClubAdmin}},
+	ActionClubManageModerators:    {Resource: ResourceClub, Roles: []Role{RoleClubAdmin}},
+	ActionClubHandleComplaints:    {Resource: ResourceClub, Roles: []Role{RoleClubAdmin, RoleClubModerator}},
+	ActionEventUpdate:             {Resource: ResourceEvent, Roles: []Role{RoleEventAdmin}},
+	ActionEventDelete:             {Resource: ResourceEvent, Roles: []Role{RoleEventAdmin}},
+	ActionEventViewRequests:       {Resource: ResourceEvent, Roles: []Role{RoleEventAdmin}},
+	ActionEventManageParticipants: {Resource: ResourceEvent, Roles: []Role{RoleEventAdmin}},
+	ActionEventViewChat:           {Resource: ResourceEvent, Roles: []Role{RoleEventAdmin, RoleEventParticipant}},
+	ActionEventCreatePost:         {Resource: ResourceEvent, Roles: []Role{RoleEventAdmin, RoleEventParticipant}},
+	ActionPostUpdate:              {Resource: ResourcePost, Roles: []Role{RolePostAuthor}},
+	ActionPostDelete:              {Resource: ResourcePost, Roles: []Role{RolePostAuthor, RoleClubAdmin, RoleClubModerator}},
+	ActionCarUpdate:               {Resource: ResourceCar, Roles: []Role{RoleCarOwner}},
+	ActionCarDelete:               {Resource: ResourceCar, Roles: []Role{RoleCarOwner}},
+	ActionPlatformModerate:        {Resource: ResourcePlatform, Roles: []Role{RolePlatformStaff}},
+}
+
+// Allowed reports whether any of the roles grants the action.
+func Allowed(action Action, roles []Role) bool {
+	rule, ok := Policy[action]
+	if !ok {
+		return false
+	}
+
+	for _, allowed := range rule.Roles {
+		for _, role := range roles {
+			if role == allowed {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,101 @@
+package authorization
+
+import "testing"
+
+var allRoles = []Role{
+	RoleClubAdmin,
+	RoleClubModerator,
+	RoleClubParticipant,
+	ClubRole("subscriber"),
+	ClubRole("participant_request"),
+	RoleEventAdmin,
+	RoleEventParticipant,
+	EventRole("spectator"),
+	EventRole("participant_request"),
+	RolePostAuthor,
+	RoleCarOwner,
+	RolePlatformStaff,
+	PlatformRole("user"),
+}
+
+// policyTable is the permission matrix written out by hand, so that a change
+// to Policy has to be made here as well.
+var policyTable = []struct {
+	action   Action
+	resource ResourceType
+	allowed  []Role
+}{
+	{ActionClubUpdate, ResourceClub, []Role{RoleClubAdmin}},
+	{ActionClubDelete, ResourceClub, []Role{RoleClubAdmin}},
+	{ActionClubViewRequests, ResourceClub, []Role{RoleClubAdmin, RoleClubModerator}},
+	{ActionClubManageParticipants, ResourceClub, []Role{RoleClubAdmin, RoleClubModerator}},
+	{ActionClubViewChat, ResourceClub, []Role{RoleClubAdmin, RoleClubModerator, RoleClubParticipant}},
+	{ActionClubCreateEvent, ResourceClub, []Role{RoleClubAdmin}},
+	{ActionClubManageModerators, ResourceClub, []Role{RoleClubAdmin}},
+	{ActionClubHandleComplaints, ResourceClub, []Role{RoleClubAdmin, RoleClubModerator}},
+	{ActionEventUpdate, ResourceEvent, []Role{RoleEventAdmin}},
+	{ActionEventDelete, ResourceEvent, []Role{RoleEventAdmin}},
+	{ActionEventViewRequests, ResourceEvent, []Role{RoleEventAdmin}},
+	{ActionEventManageParticipants, ResourceEvent, []Role{RoleEventAdmin}},
+	{ActionEventViewChat, ResourceEvent, []Role{RoleEventAdmin, RoleEventParticipant}},
+	{ActionEventCreatePost, ResourceEvent, []Role{RoleEventAdmin, RoleEventParticipant}},
+	{ActionPostUpdate, ResourcePost, []Role{RolePostAuthor}},
+	{ActionPostDelete, ResourcePost, []Role{RolePostAuthor, RoleClubAdmin, RoleClubModerator}},
+	{ActionCarUpdate, ResourceCar, []Role{RoleCarOwner}},
+	{ActionCarDelete, ResourceCar, []Role{RoleCarOwner}},
+	{ActionPlatformModerate, ResourcePlatform, []Role{RolePlatformStaff}},
+}
+
+func TestPolicyCoversEveryAction(t *testing.T) {
+	if len(policyTable) != len(Policy) {
+		t.Fatalf("policy has %d actions, the test table has %d", len(Policy), len(policyTable))
+	}
+	for _, tt := range policyTable {
+		rule, ok := Policy[tt.action]
+		if !ok {
+			t.Errorf("%s is missing from Policy", tt.action)
+			continue
+		}
+		if rule.Resource != tt.resource {
+			t.Errorf("%s is checked on %s, want %s", tt.action, rule.Resource, tt.resource)
+		}
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	for _, tt := range policyTable {
+		for _, role := range allRoles {
+			want := false
+			for _, allowed := range tt.allowed {
+				if role == allowed {
+					want = true
+				}
+			}
+
+			got := Allowed(tt.action, []Role{role})
+			if got != want {
+				t.Errorf("Allowed(%s, [%s]) = %v, want %v", tt.action, role, got, want)
+			}
+		}
+	}
+}
+
+func TestAllowedRoleSets(t *testing.T) {
+	tests := []struct {
+		name   string
+		action Action
+		roles  []Role
+		want   bool
+	}{
+		{"no roles", ActionClubViewChat, nil, false},
+		{"unknown action", Action("club:transfer"), []Role{RoleClubAdmin}, false},
+		{"any matching role", ActionPostDelete, []Role{RoleEventParticipant, RoleClubModerator}, true},
+		{"inherited club role on an event post", ActionPostDelete, []Role{RoleEventAdmin, RoleClubParticipant}, false},
+		{"staff has no club role", ActionClubDelete, []Role{RolePlatformStaff}, false},
+	}
+	for _, tt := range tests {
+		if got := Allowed(tt.action, tt.roles); got != tt.want {
+			t.Errorf("%s: Allowed(%s, %v) = %v, want %v", tt.name, tt.action, tt.roles, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+package authorization
+
+type IAuthorizationRepository interface {
+	SelectRoles(resource ResourceType, resourceID uint64, userID uint64) ([]Role, error)
+}
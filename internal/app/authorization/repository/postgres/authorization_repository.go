@@ -0,0 +1,135 @@
+package authorization_repository
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+)
+
+type AuthorizationRepository struct {
+	dbConn *sql.DB
+}
+
+func NewAuthorizationRepository(conn *sql.DB) authorization.IAuthorizationRepository {
+	return &AuthorizationRepository{
+		dbConn: conn,
+	}
+}
+
+func (ar *AuthorizationRepository) SelectRoles(resource authorization.ResourceType, resourceID uint64, userID uint64) ([]authorization.Role, error) {
+	switch resource {
+	case authorization.ResourceClub:
+		return ar.selectClubRoles(resourceID, userID)
+	case authorization.ResourceEvent:
+		return ar.selectEventRoles(resourceID, userID)
+	case authorization.ResourcePost:
+		return ar.selectPostRoles(resourceID, userID)
+	case authorization.ResourceCar:
+		return ar.selectCarRoles(resourceID, userID)
+	case authorization.ResourcePlatform:
+		return ar.selectPlatformRoles(userID)
+	}
+
+	return nil, fmt.Errorf("unknown resource type %q", resource)
+}
+
+func (ar *AuthorizationRepository) selectClubRoles(clubID uint64, userID uint64) ([]authorization.Role, error) {
+	var clubStatus sql.NullString
+	err := ar.dbConn.QueryRow(
+		`SELECT uc.status FROM clubs as c
+				LEFT JOIN users_clubs as uc on uc.club_id = c.id and uc.user_id = $2
+				WHERE c.id = $1`, clubID, userID).Scan(&clubStatus)
+	if err == sql.ErrNoRows {
+		return nil, authorization.ErrResourceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]authorization.Role, 0, 1)
+	if clubStatus.Valid {
+		roles = append(roles, authorization.ClubRole(clubStatus.String))
+	}
+	return roles, nil
+}
+
+func (ar *AuthorizationRepository) selectEventRoles(eventID uint64, userID uint64) ([]authorization.Role, error) {
+	var eventStatus, clubStatus sql.NullString
+	err := ar.dbConn.QueryRow(
+		`SELECT ue.status, uc.status FROM events as e
+				LEFT JOIN users_events as ue on ue.event_id = e.id and ue.user_id = $2
+				LEFT JOIN users_clubs as uc on uc.club_id = e.club_id and uc.user_id = $2
+				WHERE e.id = $1`, eventID, userID).Scan(&eventStatus, &clubStatus)
+	if err == sql.ErrNoRows {
+		return nil, authorization.ErrResourceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return eventRoles(eventStatus, clubStatus), nil
+}
+
+func (ar *AuthorizationRepository) selectPostRoles(postID uint64, userID uint64) ([]authorization.Role, error) {
+	var authorID uint64
+	var eventStatus, clubStatus sql.NullString
+	err := ar.dbConn.QueryRow(
+		`SELECT p.user_id, ue.status, uc.status FROM events_posts as p
+				INNER JOIN events as e on e.id = p.event_id
+				LEFT JOIN users_events as ue on ue.event_id = e.id and ue.user_id = $2
+				LEFT JOIN users_clubs as uc on uc.club_id = e.club_id and uc.user_id = $2
+				WHERE p.id = $1`, postID, userID).Scan(&authorID, &eventStatus, &clubStatus)
+	if err == sql.ErrNoRows {
+		return nil, authorization.ErrResourceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	roles := eventRoles(eventStatus, clubStatus)
+	if authorID == userID {
+		roles = append(roles, authorization.RolePostAuthor)
+	}
+	return roles, nil
+}
+
+func (ar *AuthorizationRepository) selectCarRoles(carID uint64, userID uint64) ([]authorization.Role, error) {
+	var ownerID uint64
+	err := ar.dbConn.QueryRow(`SELECT owner_id FROM cars WHERE id = $1`, carID).Scan(&ownerID)
+	if err == sql.ErrNoRows {
+		return nil, authorization.ErrResourceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]authorization.Role, 0, 1)
+	if ownerID == userID {
+		roles = append(roles, authorization.RoleCarOwner)
+	}
+	return roles, nil
+}
+
+func (ar *AuthorizationRepository) selectPlatformRoles(userID uint64) ([]authorization.Role, error) {
+	var role string
+	err := ar.dbConn.QueryRow(`SELECT role FROM users WHERE vk_id = $1 and banned = false`, userID).Scan(&role)
+	if err == sql.ErrNoRows {
+		return []authorization.Role{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return []authorization.Role{authorization.PlatformRole(role)}, nil
+}
+
+func eventRoles(eventStatus sql.NullString, clubStatus sql.NullString) []authorization.Role {
+	roles := make([]authorization.Role, 0, 3)
+	if eventStatus.Valid {
+		roles = append(roles, authorization.EventRole(eventStatus.String))
+	}
+	if clubStatus.Valid {
+		roles = append(roles, authorization.ClubRole(clubStatus.String))
+	}
+	return roles
+}
@@ -0,0 +1,5 @@
+package authorization
+
+type IAuthorizationUsecase interface {
+	Can(userID uint64, action Action, resourceID uint64) error
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+)
+
+type AuthorizationUsecase struct {
+	authRepo authorization.IAuthorizationRepository
+}
+
+func NewAuthorizationUsecase(repo authorization.IAuthorizationRepository) authorization.IAuthorizationUsecase {
+	return &AuthorizationUsecase{
+		authRepo: repo,
+	}
+}
+
+// Can returns nil if the user may perform the action on the resource,
+// authorization.ErrForbidden if not and authorization.ErrResourceNotFound
+// if there is no such resource.
+func (au *AuthorizationUsecase) Can(userID uint64, action authorization.Action, resourceID uint64) error {
+	rule, ok := authorization.Policy[action]
+	if !ok {
+		return authorization.ErrForbidden
+	}
+
+	roles, err := au.authRepo.SelectRoles(rule.Resource, resourceID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !authorization.Allowed(action, roles) {
+		return authorization.ErrForbidden
+	}
+
+	return nil
+}
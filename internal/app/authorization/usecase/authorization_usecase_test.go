@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"errors"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+	"testing"
+)
+
+const (
+	clubID    = 1
+	eventID   = 2
+	adminID   = 10
+	moderID   = 11
+	memberID  = 12
+	staffID   = 13
+	strangeID = 14
+)
+
+type resourceKey struct {
+	resource authorization.ResourceType
+	id       uint64
+}
+
+// fakeRoles answers SelectRoles the way the postgres repository does: not found
+// for an unknown resource and the roles of the user, possibly none, otherwise.
+type fakeRoles struct {
+	roles map[resourceKey]map[uint64][]authorization.Role
+	calls int
+}
+
+func (fr *fakeRoles) SelectRoles(resource authorization.ResourceType, resourceID uint64, userID uint64) ([]authorization.Role, error) {
+	fr.calls++
+	if resource == authorization.ResourcePlatform {
+		return fr.roles[resourceKey{resource, 0}][userID], nil
+	}
+	users, ok := fr.roles[resourceKey{resource, resourceID}]
+	if !ok {
+		return nil, authorization.ErrResourceNotFound
+	}
+	return users[userID], nil
+}
+
+func newFakeRoles() *fakeRoles {
+	return &fakeRoles{roles: map[resourceKey]map[uint64][]authorization.Role{
+		{authorization.ResourceClub, clubID}: {
+			adminID:  {authorization.RoleClubAdmin},
+			moderID:  {authorization.RoleClubModerator},
+			memberID: {authorization.RoleClubParticipant},
+		},
+		{authorization.ResourceEvent, eventID}: {
+			adminID:  {authorization.RoleEventAdmin, authorization.RoleClubAdmin},
+			moderID:  {authorization.RoleClubModerator},
+			memberID: {authorization.RoleEventParticipant, authorization.RoleClubParticipant},
+		},
+		{authorization.ResourcePlatform, 0}: {
+			staffID:  {authorization.RolePlatformStaff},
+			adminID:  {authorization.PlatformRole("user")},
+			memberID: {authorization.PlatformRole("user")},
+		},
+	}}
+}
+
+func TestCan(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   uint64
+		action   authorization.Action
+		resource uint64
+		want     error
+	}{
+		{"staff moderates the platform", staffID, authorization.ActionPlatformModerate, 0, nil},
+		{"club admin does not moderate the platform", adminID, authorization.ActionPlatformModerate, 0, authorization.ErrForbidden},
+		{"staff gets no club permissions from the platform role", staffID, authorization.ActionClubUpdate, clubID, authorization.ErrForbidden},
+		{"staff gets no club complaints from the platform role", staffID, authorization.ActionClubHandleComplaints, clubID, authorization.ErrForbidden},
+
+		{"admin updates the club", adminID, authorization.ActionClubUpdate, clubID, nil},
+		{"moderator cannot update the club", moderID, authorization.ActionClubUpdate, clubID, authorization.ErrForbidden},
+		{"participant cannot update the club", memberID, authorization.ActionClubUpdate, clubID, authorization.ErrForbidden},
+
+		{"admin deletes the club", adminID, authorization.ActionClubDelete, clubID, nil},
+		{"moderator cannot delete the club", moderID, authorization.ActionClubDelete, clubID, authorization.ErrForbidden},
+
+		{"admin manages moderators", adminID, authorization.ActionClubManageModerators, clubID, nil},
+		{"moderator cannot manage moderators", moderID, authorization.ActionClubManageModerators, clubID, authorization.ErrForbidden},
+
+		{"admin sees requests", adminID, authorization.ActionClubViewRequests, clubID, nil},
+		{"moderator sees requests", moderID, authorization.ActionClubViewRequests, clubID, nil},
+		{"participant cannot see requests", memberID, authorization.ActionClubViewRequests, clubID, authorization.ErrForbidden},
+
+		{"moderator handles complaints", moderID, authorization.ActionClubHandleComplaints, clubID, nil},
+		{"participant cannot handle complaints", memberID, authorization.ActionClubHandleComplaints, clubID, authorization.ErrForbidden},
+
+		{"participant sees the chat", memberID, authorization.ActionClubViewChat, clubID, nil},
+		{"stranger cannot see the chat", strangeID, authorization.ActionClubViewChat, clubID, authorization.ErrForbidden},
+
+		{"event admin updates the event", adminID, authorization.ActionEventUpdate, eventID, nil},
+		{"club moderator cannot update the event", moderID, authorization.ActionEventUpdate, eventID, authorization.ErrForbidden},
+		{"event participant posts", memberID, authorization.ActionEventCreatePost, eventID, nil},
+
+		{"unknown club", adminID, authorization.ActionClubUpdate, 404, authorization.ErrResourceNotFound},
+		{"unknown event", adminID, authorization.ActionEventUpdate, 404, authorization.ErrResourceNotFound},
+		{"unknown action", adminID, authorization.Action("club:transfer"), clubID, authorization.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			au := NewAuthorizationUsecase(newFakeRoles())
+
+			err := au.Can(tt.userID, tt.action, tt.resource)
+			if err != tt.want {
+				t.Fatalf("Can(%d, %s, %d) = %v, want %v", tt.userID, tt.action, tt.resource, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanUnknownActionSkipsRepository(t *testing.T) {
+	repo := newFakeRoles()
+	au := NewAuthorizationUsecase(repo)
+
+	err := au.Can(adminID, authorization.Action("club:transfer"), clubID)
+	if err != authorization.ErrForbidden {
+		t.Fatalf("Can() = %v, want %v", err, authorization.ErrForbidden)
+	}
+	if repo.calls != 0 {
+		t.Fatalf("SelectRoles was called %d times for an unknown action", repo.calls)
+	}
+}
+
+type failingRoles struct{}
+
+func (failingRoles) SelectRoles(authorization.ResourceType, uint64, uint64) ([]authorization.Role, error) {
+	return nil, errDB
+}
+
+var errDB = errors.New("connection refused")
+
+func TestCanRepositoryError(t *testing.T) {
+	au := NewAuthorizationUsecase(failingRoles{})
+
+	err := au.Can(adminID, authorization.ActionClubUpdate, clubID)
+	if err != errDB {
+		t.Fatalf("Can() = %v, want %v", err, errDB)
+	}
+}
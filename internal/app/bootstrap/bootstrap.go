@@ -0,0 +1,253 @@
+// Package bootstrap wires the clients, repositories, usecases, HTTP handlers and gRPC servers
+// that every command of the binary shares.
+package bootstrap
+
+import (
+	"fmt"
+	"github.com/dantedoyl/car-life-api/internal/app/admin"
+	admin_delivery "github.com/dantedoyl/car-life-api/internal/app/admin/delivery/http"
+	admin_repository "github.com/dantedoyl/car-life-api/internal/app/admin/repository/postgres"
+	admin_usecase "github.com/dantedoyl/car-life-api/internal/app/admin/usecase"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+	authorization_repository "github.com/dantedoyl/car-life-api/internal/app/authorization/repository/postgres"
+	authorization_usecase "github.com/dantedoyl/car-life-api/internal/app/authorization/usecase"
+	"github.com/dantedoyl/car-life-api/internal/app/clients/database"
+	"github.com/dantedoyl/car-life-api/internal/app/clients/vk"
+	clubs "github.com/dantedoyl/car-life-api/internal/app/clubs"
+	clubs_grpc "github.com/dantedoyl/car-life-api/internal/app/clubs/delivery/grpc"
+	clubs_delivery "github.com/dantedoyl/car-life-api/internal/app/clubs/delivery/http"
+	clubs_repository "github.com/dantedoyl/car-life-api/internal/app/clubs/repository/postgres"
+	clubs_usecase "github.com/dantedoyl/car-life-api/internal/app/clubs/usecase"
+	"github.com/dantedoyl/car-life-api/internal/app/config"
+	"github.com/dantedoyl/car-life-api/internal/app/events"
+	events_grpc "github.com/dantedoyl/car-life-api/internal/app/events/delivery/grpc"
+	events_delivery "github.com/dantedoyl/car-life-api/internal/app/events/delivery/http"
+	events_repository "github.com/dantedoyl/car-life-api/internal/app/events/repository/postgres"
+	events_usecase "github.com/dantedoyl/car-life-api/internal/app/events/usecase"
+	"github.com/dantedoyl/car-life-api/internal/app/events_posts"
+	events_posts_grpc "github.com/dantedoyl/car-life-api/internal/app/events_posts/delivery/grpc"
+	events_posts_delivery "github.com/dantedoyl/car-life-api/internal/app/events_posts/delivery/http"
+	events_posts_repository "github.com/dantedoyl/car-life-api/internal/app/events_posts/repository/postgres"
+	events_posts_usecase "github.com/dantedoyl/car-life-api/internal/app/events_posts/usecase"
+	"github.com/dantedoyl/car-life-api/internal/app/graphql"
+	"github.com/dantedoyl/car-life-api/internal/app/health"
+	"github.com/dantedoyl/car-life-api/internal/app/idempotency"
+	idempotency_postgres "github.com/dantedoyl/car-life-api/internal/app/idempotency/store/postgres"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"github.com/dantedoyl/car-life-api/internal/app/metrics"
+	"github.com/dantedoyl/car-life-api/internal/app/middleware"
+	"github.com/dantedoyl/car-life-api/internal/app/mini_events"
+	mini_events_grpc "github.com/dantedoyl/car-life-api/internal/app/mini_events/delivery/grpc"
+	mini_events_delivery "github.com/dantedoyl/car-life-api/internal/app/mini_events/delivery/http"
+	mini_events_repository "github.com/dantedoyl/car-life-api/internal/app/mini_events/repository/postgres"
+	mini_events_usecase "github.com/dantedoyl/car-life-api/internal/app/mini_events/usecase"
+	"github.com/dantedoyl/car-life-api/internal/app/ratelimit"
+	ratelimit_memory "github.com/dantedoyl/car-life-api/internal/app/ratelimit/store/memory"
+	ratelimit_tarantool "github.com/dantedoyl/car-life-api/internal/app/ratelimit/store/tarantool"
+	"github.com/dantedoyl/car-life-api/internal/app/sessions"
+	sessions_memory "github.com/dantedoyl/car-life-api/internal/app/sessions/store/memory"
+	sessions_postgres "github.com/dantedoyl/car-life-api/internal/app/sessions/store/postgres"
+	sessions_tarantool "github.com/dantedoyl/car-life-api/internal/app/sessions/store/tarantool"
+	"github.com/dantedoyl/car-life-api/internal/app/users"
+	users_grpc "github.com/dantedoyl/car-life-api/internal/app/users/delivery/grpc"
+	users_delivery "github.com/dantedoyl/car-life-api/internal/app/users/delivery/http"
+	users_repository "github.com/dantedoyl/car-life-api/internal/app/users/repository/postgres"
+	users_usecase "github.com/dantedoyl/car-life-api/internal/app/users/usecase"
+	"github.com/dantedoyl/car-life-api/internal/app/utils"
+	"github.com/dantedoyl/car-life-api/pkg/carlifepb"
+	"github.com/gorilla/mux"
+	httpSwagger "github.com/swaggo/http-swagger"
+	"google.golang.org/grpc"
+	"net/http"
+	"time"
+)
+
+type App struct {
+	Config *config.Config
+	Log    *logger.Logger
+
+	Postgres *database.Postgres
+	// Tarantool is nil unless it backs the sessions or the rate limits.
+	Tarantool    *database.Tarantool
+	Sessions     sessions.SessionStore
+	RateLimiter  ratelimit.Store
+	Health       *health.Handler
+	VK           *vk.VKClient
+	LaunchParams *vk.LaunchParamsVerifier
+	Idempotency  idempotency.Store
+
+	Users       users.IUsersUsecase
+	Auth        authorization.IAuthorizationUsecase
+	Clubs       clubs.IClubsUsecase
+	Events      events.IEventsUsecase
+	MiniEvents  mini_events.IMiniEventsUsecase
+	EventsPosts events_posts.IEventsPostsUsecase
+	Admin       admin.IAdminUsecase
+
+	memoryStore    *sessions_memory.MemoryStore
+	rateLimitStore *ratelimit_memory.MemoryStore
+}
+
+// New connects to the storages from cfg and builds the usecases on top of them.
+// The caller must Close the App.
+func New(cfg *config.Config, log *logger.Logger) (*App, error) {
+	postgresDB, err := database.NewPostgres(cfg.Postgres)
+	if err != nil {
+		return nil, err
+	}
+	metrics.RegisterDBStats("postgres", postgresDB.GetDatabase())
+
+	app := &App{
+		Config:   cfg,
+		Log:      log,
+		Postgres: postgresDB,
+		Health:   health.NewHandler(cfg.Server.HealthCheckTimeout),
+	}
+	app.Health.Add("postgres", postgresDB.Ping)
+
+	if cfg.UsesTarantool() {
+		app.Tarantool, err = database.NewTarantool(cfg.Tarantool)
+		if err != nil {
+			postgresDB.Close()
+			return nil, fmt.Errorf("tarantool: %w", err)
+		}
+		app.Health.Add("tarantool", app.Tarantool.Ping)
+	}
+
+	switch cfg.Sessions.Store {
+	case "memory":
+		app.memoryStore = sessions_memory.NewMemoryStore(time.Minute)
+		app.Sessions = app.memoryStore
+	case "postgres":
+		app.Sessions = sessions_postgres.NewPostgresStore(postgresDB.GetDatabase())
+	default:
+		app.Sessions = sessions_tarantool.NewTarantoolStore(app.Tarantool.GetConnection())
+	}
+
+	app.rateLimitStore = ratelimit_memory.NewMemoryStore(time.Minute)
+	app.RateLimiter = app.rateLimitStore
+	if cfg.RateLimit.Store == "tarantool" {
+		app.RateLimiter = ratelimit.NewFallbackStore(ratelimit_tarantool.NewTarantoolStore(app.Tarantool.GetConnection()), app.rateLimitStore, log)
+	}
+
+	app.VK = vk.NewVKClient(cfg.VK)
+	app.LaunchParams = vk.NewLaunchParamsVerifier(cfg.VK.AppSecret, cfg.VK.LaunchParamsTTL)
+
+	db := postgresDB.GetDatabase()
+	app.Idempotency = idempotency_postgres.NewPostgresStore(db)
+	app.Users = users_usecase.NewUsersUsecase(users_repository.NewUserRepository(db), app.Sessions, users_usecase.SessionConfig{
+		AccessTTL:     cfg.Sessions.AccessTTL,
+		RefreshTTL:    cfg.Sessions.RefreshTTL,
+		SlidingWindow: cfg.Sessions.SlidingWindow,
+	})
+	app.Auth = authorization_usecase.NewAuthorizationUsecase(authorization_repository.NewAuthorizationRepository(db))
+	app.Clubs = clubs_usecase.NewClubsUsecase(clubs_repository.NewClubRepository(db))
+	app.Events = events_usecase.NewEventsUsecase(events_repository.NewProductRepository(db))
+	app.MiniEvents = mini_events_usecase.NewMiniEventsUsecase(mini_events_repository.NewMiniEventsRepository(db))
+	app.EventsPosts = events_posts_usecase.NewEventsPostsUsecase(events_posts_repository.NewEventsPostsRepository(db))
+	app.Admin = admin_usecase.NewAdminUsecase(admin_repository.NewAdminRepository(db), app.Users, app.Clubs, app.Events, app.EventsPosts)
+
+	return app, nil
+}
+
+func (a *App) middleware() *middleware.Middleware {
+	rl := a.Config.RateLimit
+	rateLimits := ratelimit.Limits{
+		ratelimit.ClassAuth:          ratelimit.NewLimit(rl.Auth.Requests, rl.Auth.Per, rl.Auth.Burst),
+		ratelimit.ClassComplaints:    ratelimit.NewLimit(rl.Complaints.Requests, rl.Complaints.Per, rl.Complaints.Burst),
+		ratelimit.ClassPosts:         ratelimit.NewLimit(rl.Posts.Requests, rl.Posts.Per, rl.Posts.Burst),
+		ratelimit.ClassUploads:       ratelimit.NewLimit(rl.Uploads.Requests, rl.Uploads.Per, rl.Uploads.Burst),
+		ratelimit.ClassParticipation: ratelimit.NewLimit(rl.Participation.Requests, rl.Participation.Per, rl.Participation.Burst),
+	}
+	return middleware.NewMiddleware(a.Users, a.Auth, a.Idempotency, a.Config.Idempotency.TTL, a.RateLimiter, rateLimits,
+		utils.NewTrustedProxies(a.Config.Server.TrustedProxies), a.Log)
+}
+
+// Router serves the probes, the uploaded images, the v1 and v2 APIs and the GraphQL endpoint.
+func (a *App) Router() *mux.Router {
+	mw := a.middleware()
+	cors := middleware.NewCORS(a.Config.CORS)
+
+	router := mux.NewRouter()
+	router.Use(mw.RequestLogMiddleware)
+	router.Use(middleware.MetricsMiddleware)
+
+	a.Health.Configure(router)
+
+	// Uploads are saved under a new random name every time, so a cached image never goes stale.
+	images := middleware.CacheControl(middleware.CacheImmutable, http.FileServer(http.Dir(".")))
+	static := router.PathPrefix("/img").Subrouter()
+	static.Handle("/clubs/{key}", images).Methods(http.MethodGet)
+	static.Handle("/events/{key}", images).Methods(http.MethodGet)
+	static.Handle("/cars/{key}", images).Methods(http.MethodGet)
+	static.Handle("/events-posts/{key}", images).Methods(http.MethodGet)
+
+	eventsHandler := events_delivery.NewEventsHandler(a.Events, a.Auth, a.VK, a.Config.VK.AppURL)
+	clubsHandler := clubs_delivery.NewClubsHandler(a.Clubs, a.VK, a.Config.VK.AppURL)
+	usersHandler := users_delivery.NewUserssHandler(a.Users, a.LaunchParams)
+	miniEventsHandler := mini_events_delivery.NewMiniEventsHandler(a.MiniEvents)
+	eventsPostsHandler := events_posts_delivery.NewEventsPostsHandler(a.EventsPosts)
+	adminHandler := admin_delivery.NewAdminHandler(a.Admin)
+
+	api := router.PathPrefix("/api/v1").Subrouter()
+	api.Use(cors.Middleware)
+	eventsHandler.Configure(api, mw)
+	clubsHandler.Configure(api, mw)
+	usersHandler.Configure(api, mw)
+	miniEventsHandler.Configure(api, mw)
+	eventsPostsHandler.Configure(api, mw)
+	adminHandler.Configure(api, mw)
+	api.PathPrefix("/swagger").Handler(httpSwagger.WrapHandler)
+
+	// v2 serves the same usecases with resource-oriented routes; v1 stays until the clients move over.
+	apiV2 := router.PathPrefix("/api/v2").Subrouter()
+	apiV2.Use(cors.Middleware)
+	eventsHandler.ConfigureV2(apiV2, mw)
+	clubsHandler.ConfigureV2(apiV2, mw)
+	usersHandler.ConfigureV2(apiV2, mw)
+	miniEventsHandler.ConfigureV2(apiV2, mw)
+	eventsPostsHandler.ConfigureV2(apiV2, mw)
+	adminHandler.ConfigureV2(apiV2, mw)
+
+	// /api/graphql answers the queries of the screens that would otherwise call several v1 routes.
+	gqlAPI := router.PathPrefix("/api").Subrouter()
+	gqlAPI.Use(cors.Middleware)
+	graphql.NewHandler(a.Users, a.Clubs, a.Events, a.EventsPosts, a.MiniEvents, a.Auth, a.VK).Configure(gqlAPI, mw)
+
+	return router
+}
+
+// MetricsRouter serves /metrics. It is served on server.metrics_addr rather than with
+// the public Router, so that only the internal network can scrape it.
+func (a *App) MetricsRouter() *mux.Router {
+	router := mux.NewRouter()
+	metrics.Configure(router)
+	return router
+}
+
+// GRPCServer serves the users, clubs, events, posts and mini events services of pkg/carlifepb
+// to the other backend services, with the same usecases and sessions as the HTTP API.
+func (a *App) GRPCServer() *grpc.Server {
+	mw := a.middleware()
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(mw.GRPCInterceptors()...))
+
+	carlifepb.RegisterUsersServiceServer(server, users_grpc.NewUsersServer(a.Users))
+	carlifepb.RegisterClubsServiceServer(server, clubs_grpc.NewClubsServer(a.Clubs, a.Auth))
+	carlifepb.RegisterEventsServiceServer(server, events_grpc.NewEventsServer(a.Events, a.Auth))
+	carlifepb.RegisterPostsServiceServer(server, events_posts_grpc.NewEventsPostsServer(a.EventsPosts))
+	carlifepb.RegisterMiniEventsServiceServer(server, mini_events_grpc.NewMiniEventsServer(a.MiniEvents))
+
+	return server
+}
+
+// Close releases the connections. Call it only after nothing uses the App anymore.
+func (a *App) Close() {
+	a.Postgres.Close()
+	if a.Tarantool != nil {
+		a.Tarantool.Close()
+	}
+	if a.memoryStore != nil {
+		a.memoryStore.Stop()
+	}
+	a.rateLimitStore.Stop()
+}
@@ -1,7 +1,9 @@
 package database
 
 import (
+	"context"
 	"database/sql"
+	"github.com/dantedoyl/car-life-api/internal/app/config"
 	_ "github.com/jackc/pgx/stdlib"
 )
 
@@ -9,12 +11,16 @@ type Postgres struct {
 	postgresDatabase *sql.DB
 }
 
-func NewPostgres(dataSourceName string) (*Postgres, error) {
-	sqlConn, err := sql.Open("pgx", dataSourceName)
+func NewPostgres(cfg config.PostgresConfig) (*Postgres, error) {
+	sqlConn, err := sql.Open("pgx", cfg.DSN)
 	if err != nil {
 		return nil, err
 	}
 
+	sqlConn.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlConn.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlConn.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+
 	if err := sqlConn.Ping(); err != nil {
 		return nil, err
 	}
@@ -28,6 +34,10 @@ func (p *Postgres) GetDatabase() *sql.DB {
 	return p.postgresDatabase
 }
 
+func (p *Postgres) Ping(ctx context.Context) error {
+	return p.postgresDatabase.PingContext(ctx)
+}
+
 func (p *Postgres) Close() {
 	p.postgresDatabase.Close()
 }
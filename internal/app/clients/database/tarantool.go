@@ -0,0 +1,50 @@
+package database
+
+import (
+	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/config"
+	"github.com/tarantool/go-tarantool"
+)
+
+type Tarantool struct {
+	conn *tarantool.Connection
+}
+
+func NewTarantool(cfg config.TarantoolConfig) (*Tarantool, error) {
+	conn, err := tarantool.Connect(cfg.Addr, tarantool.Opts{
+		User:    cfg.User,
+		Pass:    cfg.Password,
+		Timeout: cfg.Timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tarantool{
+		conn: conn,
+	}, nil
+}
+
+func (t *Tarantool) GetConnection() *tarantool.Connection {
+	return t.conn
+}
+
+// Ping sends a no-op request to check that Tarantool answers.
+func (t *Tarantool) Ping(ctx context.Context) error {
+	done := make(chan error, 1)
+	go func() {
+		_, err := t.conn.Ping()
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (t *Tarantool) Close() {
+	t.conn.Close()
+}
@@ -0,0 +1,11 @@
+package database
+
+import "strconv"
+
+// Visible is the condition for a row of the table aliased as alias to be shown to the
+// user whose vk_id is the query parameter number param. Content hidden by moderation
+// is shown to platform staff only, so that they can still review it.
+func Visible(alias string, param int) string {
+	return `(` + alias + `.hidden = false or exists (SELECT 1 FROM users as staff
+				WHERE staff.vk_id = $` + strconv.Itoa(param) + ` and staff.role = 'staff' and staff.banned = false))`
+}
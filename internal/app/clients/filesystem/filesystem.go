@@ -5,7 +5,9 @@ import (
 	"mime/multipart"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/dantedoyl/car-life-api/internal/app/metrics"
 	"github.com/google/uuid"
 	"github.com/h2non/bimg"
 )
@@ -25,6 +27,7 @@ func InsertPhoto(fileHeader *multipart.FileHeader, dirname string) (string, erro
 	filename := strings.Replace(uuid.New().String(), "-", "", -1) + ".webp"
 	filepath := dirname + filename
 
+	start := time.Now()
 	converted, err := bimg.NewImage(buffer).Convert(bimg.WEBP)
 	if err != nil {
 		return "", err
@@ -34,6 +37,7 @@ func InsertPhoto(fileHeader *multipart.FileHeader, dirname string) (string, erro
 	if err != nil {
 		return "", err
 	}
+	metrics.ObserveSince(metrics.ImageProcessingDuration, start)
 
 	err = bimg.Write("./"+filepath, processed)
 	if err != nil {
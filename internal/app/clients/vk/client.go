@@ -2,50 +2,91 @@ package vk
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/SevereCloud/vksdk/v2/api"
 	"github.com/SevereCloud/vksdk/v2/api/params"
+	"github.com/dantedoyl/car-life-api/internal/app/config"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"github.com/dantedoyl/car-life-api/internal/app/metrics"
 	"io"
-	"log"
 	"mime/multipart"
 	"net/http"
-	"time"
 )
 
+// Client is the part of the VK API the handlers use.
+type Client interface {
+	CreatChat(ctx context.Context, title string) (int, error)
+	EditChat(ctx context.Context, id int, title string) error
+	UploadChatPhoto(ctx context.Context, id int, fileHeader *multipart.FileHeader) error
+	GetChatLink(ctx context.Context, id int) (string, error)
+	CreatMessage(ctx context.Context, userID int, msg string) error
+}
+
 type VKClient struct {
 	serviceClient *api.VK
 	groupClient   *api.VK
+	httpClient    *http.Client
 }
 
-func NewVKClient(serviceKey string, groupKey string) *VKClient {
+func NewVKClient(cfg config.VKConfig) *VKClient {
+	httpClient := &http.Client{
+		Timeout: cfg.Timeout,
+	}
+
+	serviceClient := api.NewVK(cfg.ServiceKey)
+	serviceClient.Client = httpClient
+	groupClient := api.NewVK(cfg.GroupKey)
+	groupClient.Client = httpClient
+
 	return &VKClient{
-		serviceClient: api.NewVK(serviceKey),
-		groupClient:   api.NewVK(groupKey),
+		serviceClient: serviceClient,
+		groupClient:   groupClient,
+		httpClient:    httpClient,
 	}
 }
 
-func (vk *VKClient) CreatChat(title string) (int, error) {
+// track counts the outcome of a VK call and logs a failure with the request logger from ctx.
+// It returns err unchanged.
+func track(ctx context.Context, method string, err error) error {
+	if err != nil {
+		metrics.VKRequests.WithLabelValues(method, "failure").Inc()
+		logger.FromContext(ctx).Error("vk request failed", "method", method, "error", err)
+		return err
+	}
+	metrics.VKRequests.WithLabelValues(method, "success").Inc()
+	return nil
+}
+
+func (vk *VKClient) CreatChat(ctx context.Context, title string) (int, error) {
 	chat := params.NewMessagesCreateChatBuilder()
 	chat.Title(title)
-	chatInfo, err := vk.groupClient.MessagesCreateChat(chat.Params)
-	if err != nil {
+	chatInfo, err := vk.groupClient.MessagesCreateChat(chat.Params.WithContext(ctx))
+	if err = track(ctx, "messages.createChat", err); err != nil {
 		return 0, err
 	}
 
 	return chatInfo, nil
 }
 
-func (vk *VKClient) UploadChatPhoto(id int, fileHeader *multipart.FileHeader) error {
+// EditChat renames the chat.
+func (vk *VKClient) EditChat(ctx context.Context, id int, title string) error {
+	chat := params.NewMessagesEditChatBuilder()
+	chat.ChatID(id)
+	chat.Title(title)
+	_, err := vk.groupClient.MessagesEditChat(chat.Params.WithContext(ctx))
+	return track(ctx, "messages.editChat", err)
+}
+
+func (vk *VKClient) UploadChatPhoto(ctx context.Context, id int, fileHeader *multipart.FileHeader) error {
 	chat := params.NewPhotosGetChatUploadServerBuilder()
 	chat.ChatID(id)
-	chatInfo, err := vk.groupClient.PhotosGetChatUploadServer(chat.Params)
-	if err != nil {
+	chatInfo, err := vk.groupClient.PhotosGetChatUploadServer(chat.Params.WithContext(ctx))
+	if err = track(ctx, "photos.getChatUploadServer", err); err != nil {
 		return err
 	}
-	client := &http.Client{
-		Timeout: time.Second * 10,
-	}
+	client := vk.httpClient
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
 	fw, err := writer.CreateFormFile("file", fileHeader.Filename)
@@ -65,16 +106,20 @@ func (vk *VKClient) UploadChatPhoto(id int, fileHeader *multipart.FileHeader) er
 	}
 	writer.Close()
 
-	req, err := http.NewRequest("POST", chatInfo.UploadURL, bytes.NewReader(body.Bytes()))
+	req, err := http.NewRequestWithContext(ctx, "POST", chatInfo.UploadURL, bytes.NewReader(body.Bytes()))
 	if err != nil {
 		return err
 	}
 	req.Header.Set("Content-Type", writer.FormDataContentType())
-	rsp, _ := client.Do(req)
-	if rsp.StatusCode != http.StatusOK {
-		log.Printf("Request failed with response code: %d", rsp.StatusCode)
+	rsp, err := client.Do(req)
+	if err != nil {
+		return track(ctx, "chat photo upload", err)
 	}
 	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return track(ctx, "chat photo upload", fmt.Errorf("upload server responded with %d", rsp.StatusCode))
+	}
+	track(ctx, "chat photo upload", nil)
 
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	if err != nil {
@@ -92,20 +137,20 @@ func (vk *VKClient) UploadChatPhoto(id int, fileHeader *multipart.FileHeader) er
 
 	chatUpload := params.NewMessagesSetChatPhotoBuilder()
 	chatUpload.File(resp.Response)
-	_, err = vk.groupClient.MessagesSetChatPhoto(chatUpload.Params)
-	if err != nil {
+	_, err = vk.groupClient.MessagesSetChatPhoto(chatUpload.Params.WithContext(ctx))
+	if err = track(ctx, "messages.setChatPhoto", err); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (vk *VKClient) GetChatLink(id int) (string, error) {
+func (vk *VKClient) GetChatLink(ctx context.Context, id int) (string, error) {
 	chat := params.NewMessagesGetInviteLinkBuilder()
 	chat.PeerID(2000000000 + id)
 	chat.Reset(true)
-	chatInfo, err := vk.groupClient.MessagesGetInviteLink(chat.Params)
-	if err != nil {
+	chatInfo, err := vk.groupClient.MessagesGetInviteLink(chat.Params.WithContext(ctx))
+	if err = track(ctx, "messages.getInviteLink", err); err != nil {
 		return "", err
 	}
 
@@ -123,13 +168,13 @@ func (vk *VKClient) CreatNotification() {
 	fmt.Println(a)
 }
 
-func (vk *VKClient) CreatMessage(userID int, msg string) error {
+func (vk *VKClient) CreatMessage(ctx context.Context, userID int, msg string) error {
 	not := params.NewMessagesSendBuilder()
 	not.Message(msg)
 	not.UserID(userID)
 	not.RandomID(0)
-	_, err := vk.groupClient.MessagesSend(not.Params)
-	if err != nil {
+	_, err := vk.groupClient.MessagesSend(not.Params.WithContext(ctx))
+	if err = track(ctx, "messages.send", err); err != nil {
 		return err
 	}
 	return nil
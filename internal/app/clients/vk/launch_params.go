@@ -0,0 +1,116 @@
+package vk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNoLaunchParams      = apperrors.Unauthorized("launch params are empty")
+	ErrInvalidLaunchParams = apperrors.Unauthorized("launch params are malformed")
+	ErrInvalidSign         = apperrors.Unauthorized("launch params sign is invalid")
+	ErrExpiredLaunchParams = apperrors.Unauthorized("launch params are expired")
+	ErrFutureLaunchParams  = apperrors.Unauthorized("launch params are from the future")
+)
+
+// maxLaunchClockSkew is how far ahead of our clock vk_ts may be, so that a signed vk_ts
+// from the future can't keep the params usable past maxAge.
+const maxLaunchClockSkew = time.Minute
+
+// LaunchParams are the verified vk_* parameters the mini app was started with.
+type LaunchParams struct {
+	UserID   uint64
+	AppID    uint64
+	Platform string
+	Ts       time.Time
+}
+
+// LaunchParamsVerifier checks the sign VK appends to the mini app launch query string.
+// See https://dev.vk.com/mini-apps/development/launch-params-sign
+type LaunchParamsVerifier struct {
+	secret []byte
+	maxAge time.Duration
+	now    func() time.Time
+}
+
+func NewLaunchParamsVerifier(appSecret string, maxAge time.Duration) *LaunchParamsVerifier {
+	return &LaunchParamsVerifier{
+		secret: []byte(appSecret),
+		maxAge: maxAge,
+		now:    time.Now,
+	}
+}
+
+// Verify accepts the raw launch query string (with or without the leading "?")
+// and returns the launch params only if the sign matches and vk_ts is fresh
+// and no more than maxLaunchClockSkew ahead of now.
+func (lv *LaunchParamsVerifier) Verify(rawQuery string) (*LaunchParams, error) {
+	rawQuery = strings.TrimPrefix(strings.TrimSpace(rawQuery), "?")
+	if rawQuery == "" {
+		return nil, ErrNoLaunchParams
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, ErrInvalidLaunchParams
+	}
+
+	sign := query.Get("sign")
+	if sign == "" {
+		return nil, ErrInvalidSign
+	}
+
+	vkParams := url.Values{}
+	for key, values := range query {
+		if strings.HasPrefix(key, "vk_") {
+			vkParams[key] = values
+		}
+	}
+
+	if !hmac.Equal([]byte(lv.Sign(vkParams)), []byte(sign)) {
+		return nil, ErrInvalidSign
+	}
+
+	userID, err := strconv.ParseUint(vkParams.Get("vk_user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		return nil, ErrInvalidLaunchParams
+	}
+
+	ts, err := strconv.ParseInt(vkParams.Get("vk_ts"), 10, 64)
+	if err != nil {
+		return nil, ErrInvalidLaunchParams
+	}
+
+	launchTime := time.Unix(ts, 0)
+	age := lv.now().Sub(launchTime)
+	if age < -maxLaunchClockSkew {
+		return nil, ErrFutureLaunchParams
+	}
+	if lv.maxAge > 0 && age > lv.maxAge {
+		return nil, ErrExpiredLaunchParams
+	}
+
+	appID, _ := strconv.ParseUint(vkParams.Get("vk_app_id"), 10, 64)
+
+	return &LaunchParams{
+		UserID:   userID,
+		AppID:    appID,
+		Platform: vkParams.Get("vk_platform"),
+		Ts:       launchTime,
+	}, nil
+}
+
+// Sign builds the base64url HMAC-SHA256 of the vk_* params sorted by key,
+// which is what VK puts into the "sign" parameter. Outside of Verify it is only
+// needed to start the mini app without VK, as the contract tests do.
+func (lv *LaunchParamsVerifier) Sign(vkParams url.Values) string {
+	mac := hmac.New(sha256.New, lv.secret)
+	mac.Write([]byte(vkParams.Encode()))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
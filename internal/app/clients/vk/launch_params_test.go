@@ -0,0 +1,85 @@
+package vk
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+)
+
+type launchParamsCase struct {
+	Name          string `json:"name"`
+	Secret        string `json:"secret"`
+	Query         string `json:"query"`
+	Now           int64  `json:"now"`
+	MaxAgeSeconds int64  `json:"max_age_seconds"`
+	UserID        uint64 `json:"user_id"`
+	Error         string `json:"error"`
+}
+
+func TestLaunchParamsVerify(t *testing.T) {
+	data, err := os.ReadFile("testdata/launch_params.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cases []launchParamsCase
+	if err := json.Unmarshal(data, &cases); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			verifier := NewLaunchParamsVerifier(tc.Secret, time.Duration(tc.MaxAgeSeconds)*time.Second)
+			verifier.now = func() time.Time { return time.Unix(tc.Now, 0) }
+
+			params, err := verifier.Verify(tc.Query)
+			if tc.Error != "" {
+				if err == nil {
+					t.Fatalf("Verify() = %+v, want error %q", params, tc.Error)
+				}
+				if err.Error() != tc.Error {
+					t.Fatalf("Verify() error = %q, want %q", err.Error(), tc.Error)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if params.UserID != tc.UserID {
+				t.Errorf("UserID = %d, want %d", params.UserID, tc.UserID)
+			}
+		})
+	}
+}
+
+func TestLaunchParamsVerifyMaxAgeBoundary(t *testing.T) {
+	const query = "vk_access_token_settings=&vk_app_id=8099557&vk_are_notifications_enabled=0&vk_is_app_user=1&vk_is_favorite=0&vk_language=ru&vk_platform=mobile_web&vk_ref=other&vk_ts=1650000000&vk_user_id=146506479&sign=Cg5pL6-1vakgnQAN2pZ9HBZgsqnSHqYwt7hPkFBGp5M"
+	launched := time.Unix(1650000000, 0)
+
+	tests := []struct {
+		name    string
+		maxAge  time.Duration
+		now     time.Time
+		expired bool
+	}{
+		{name: "at max age", maxAge: time.Hour, now: launched.Add(time.Hour)},
+		{name: "past max age", maxAge: time.Hour, now: launched.Add(time.Hour + time.Second), expired: true},
+		{name: "no max age", maxAge: 0, now: launched.Add(365 * 24 * time.Hour)},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := NewLaunchParamsVerifier("test_app_secret", tt.maxAge)
+			verifier.now = func() time.Time { return tt.now }
+
+			_, err := verifier.Verify(query)
+			if tt.expired && err != ErrExpiredLaunchParams {
+				t.Fatalf("Verify() error = %v, want %v", err, ErrExpiredLaunchParams)
+			}
+			if !tt.expired && err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package delivery
+
+import (
+	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+	clubs "github.com/dantedoyl/car-life-api/internal/app/clubs"
+	"github.com/dantedoyl/car-life-api/internal/app/grpcapi"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/pkg/carlifepb"
+)
+
+type ClubsServer struct {
+	carlifepb.UnimplementedClubsServiceServer
+	clubsUcase clubs.IClubsUsecase
+	authUcase  authorization.IAuthorizationUsecase
+}
+
+func NewClubsServer(clubsUcase clubs.IClubsUsecase, authUcase authorization.IAuthorizationUsecase) *ClubsServer {
+	return &ClubsServer{
+		clubsUcase: clubsUcase,
+		authUcase:  authUcase,
+	}
+}
+
+func (cs *ClubsServer) GetClub(ctx context.Context, req *carlifepb.GetClubRequest) (*carlifepb.Club, error) {
+	userID, authed := ctx.Value("userID").(uint64)
+
+	club, err := cs.clubsUcase.GetClubByID(req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toClub(club, authed), nil
+}
+
+func (cs *ClubsServer) GetClubs(ctx context.Context, req *carlifepb.GetClubsRequest) (*carlifepb.GetClubsResponse, error) {
+	err := grpcapi.CheckIDs("ids", req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	userID, authed := ctx.Value("userID").(uint64)
+
+	found, err := cs.clubsUcase.GetClubsByIDs(req.Ids, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.GetClubsResponse{Clubs: make([]*carlifepb.Club, 0, len(found))}
+	for _, club := range found {
+		resp.Clubs = append(resp.Clubs, toClub(club, authed))
+	}
+	return resp, nil
+}
+
+func (cs *ClubsServer) ListClubs(ctx context.Context, req *carlifepb.ListClubsRequest) (*carlifepb.ClubList, error) {
+	page, err := grpcapi.Page(req.Page)
+	if err != nil {
+		return nil, err
+	}
+	var query *string
+	if req.Query != "" {
+		query = &req.Query
+	}
+
+	found, next, err := cs.clubsUcase.GetClubs(page, query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.ClubList{Items: make([]*carlifepb.Club, 0, len(found))}
+	for _, club := range found {
+		resp.Items = append(resp.Items, toClub(club, false))
+	}
+	resp.NextCursor, resp.HasMore = grpcapi.NextCursor(next)
+	return resp, nil
+}
+
+func (cs *ClubsServer) ListTags(ctx context.Context, req *carlifepb.ListTagsRequest) (*carlifepb.ListTagsResponse, error) {
+	tags, err := cs.clubsUcase.GetTags()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.ListTagsResponse{Tags: make([]*carlifepb.Tag, 0, len(tags))}
+	for _, tag := range tags {
+		resp.Tags = append(resp.Tags, &carlifepb.Tag{Id: tag.ID, Name: tag.Name})
+	}
+	return resp, nil
+}
+
+func (cs *ClubsServer) ListClubMembers(ctx context.Context, req *carlifepb.ListClubMembersRequest) (*carlifepb.UserCardList, error) {
+	err := grpcapi.CheckStatus(req.Status, "admin", "moderator", "participant", "subscriber", "participant_request")
+	if err != nil {
+		return nil, err
+	}
+	page, err := grpcapi.Page(req.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Status == "participant_request" {
+		userID, ok := ctx.Value("userID").(uint64)
+		if !ok {
+			return nil, apperrors.Unauthorized("you're unauthorized")
+		}
+		err = cs.authUcase.Can(userID, authorization.ActionClubViewRequests, req.ClubId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cards, next, err := cs.clubsUcase.GetClubsUserByStatus(int64(req.ClubId), req.Status, page)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.UserCardList{Items: grpcapi.UserCards(cards)}
+	resp.NextCursor, resp.HasMore = grpcapi.NextCursor(next)
+	return resp, nil
+}
+
+func (cs *ClubsServer) ListClubCars(ctx context.Context, req *carlifepb.ListClubCarsRequest) (*carlifepb.CarList, error) {
+	page, err := grpcapi.Page(req.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	cars, next, err := cs.clubsUcase.GetClubsCars(int64(req.ClubId), page)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.CarList{Items: grpcapi.Cars(cars)}
+	resp.NextCursor, resp.HasMore = grpcapi.NextCursor(next)
+	return resp, nil
+}
+
+func (cs *ClubsServer) ListClubEvents(ctx context.Context, req *carlifepb.ListClubEventsRequest) (*carlifepb.EventCardList, error) {
+	page, err := grpcapi.Page(req.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	cards, next, err := cs.clubsUcase.GetClubsEvents(int64(req.ClubId), page)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.EventCardList{Items: grpcapi.EventCards(cards)}
+	resp.NextCursor, resp.HasMore = grpcapi.NextCursor(next)
+	return resp, nil
+}
+
+// toClub leaves user_status empty for a call without a session, which GetClubByID answers as "unknown".
+func toClub(club *models.Club, authed bool) *carlifepb.Club {
+	pb := &carlifepb.Club{
+		Id:                club.ID,
+		Name:              club.Name,
+		Description:       club.Description,
+		AvatarUrl:         club.AvatarUrl,
+		Tags:              club.Tags,
+		EventsCount:       int64(club.EventsCount),
+		ParticipantsCount: int64(club.ParticipantsCount),
+		SubscribersCount:  int64(club.SubscribersCount),
+		UpdatedAt:         grpcapi.Timestamp(club.UpdatedAt),
+	}
+	if club.Owner.VKID != 0 {
+		pb.Owner = grpcapi.UserCard(club.Owner)
+	}
+	if authed {
+		pb.UserStatus = club.UserStatus
+	}
+	return pb
+}
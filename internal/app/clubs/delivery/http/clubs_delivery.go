@@ -3,10 +3,14 @@ package delivery
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
 	"github.com/dantedoyl/car-life-api/internal/app/clients/vk"
 	clubs "github.com/dantedoyl/car-life-api/internal/app/clubs"
 	"github.com/dantedoyl/car-life-api/internal/app/middleware"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/ratelimit"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"github.com/dantedoyl/car-life-api/internal/app/utils"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/schema"
@@ -16,31 +20,69 @@ import (
 
 type ClubsHandler struct {
 	clubsUcase clubs.IClubsUsecase
-	vk         *vk.VKClient
+	vk         vk.Client
+	appURL     string
 }
 
-func NewClubsHandler(clubsUcase clubs.IClubsUsecase, vkCl *vk.VKClient) *ClubsHandler {
+func NewClubsHandler(clubsUcase clubs.IClubsUsecase, vkCl vk.Client, appURL string) *ClubsHandler {
 	return &ClubsHandler{
 		clubsUcase: clubsUcase,
 		vk:         vkCl,
+		appURL:     appURL,
 	}
 }
 
 func (ch *ClubsHandler) Configure(r *mux.Router, mw *middleware.Middleware) {
-	r.HandleFunc("/club/create", mw.CheckAuthMiddleware(ch.CreateClub)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/clubs/{id:[0-9]+}", mw.CheckAuthMiddleware(ch.GetClubByID)).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/clubs", mw.CheckAuthMiddleware(ch.GetClubs)).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/clubs/tags", mw.CheckAuthMiddleware(ch.GetTags)).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/clubs/{id:[0-9]+}/upload", mw.CheckAuthMiddleware(ch.UploadAvatarHandler)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/clubs/{id:[0-9]+}/{type:participate|subscribe}", mw.CheckAuthMiddleware(ch.SetUserStatusByClubID)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/clubs/{cid:[0-9]+}/participate/{uid:[0-9]+}/{type:approve|reject}", mw.CheckAuthMiddleware(ch.ApproveRejectUserParticipateInClub)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/clubs/{id:[0-9]+}/{type:participant|participant_request|subscriber}", mw.CheckAuthMiddleware(ch.GetClubsUsersByType)).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/club/create", mw.CheckAuthMiddleware(mw.Idempotent(ch.CreateClub))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(ch.GetClubByID))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubUpdate, "id", ch.UpdateClub))).Methods(http.MethodPut, http.MethodOptions)
+	r.HandleFunc("/clubs", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(ch.GetClubs))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/tags", middleware.ETag(middleware.CacheShort, mw.CheckAuthMiddleware(ch.GetTags))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/upload", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassUploads, mw.RequirePermission(authorization.ActionClubUpdate, "id", ch.UploadAvatarHandler)))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/{type:participate|subscribe}", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassParticipation, ch.SetUserStatusByClubID))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/clubs/{cid:[0-9]+}/participate/{uid:[0-9]+}/{type:approve|reject}", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubManageParticipants, "cid", ch.ApproveRejectUserParticipateInClub))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/{type:participant_request}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubViewRequests, "id", ch.GetClubsUsersByType)))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/{type:participant|subscriber}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(ch.GetClubsUsersByType))).Methods(http.MethodGet, http.MethodOptions)
 	r.HandleFunc("/clubs/{id:[0-9]+}/leave", mw.CheckAuthMiddleware(ch.LeaveClub)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/clubs/{id:[0-9]+}/cars", mw.CheckAuthMiddleware(ch.GetClubsCars)).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/clubs/{id:[0-9]+}/events", mw.CheckAuthMiddleware(ch.GetClubsEvents)).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/clubs/{id:[0-9]+}/chat_link", mw.CheckAuthMiddleware(ch.GetClubChatLink)).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/clubs/{id:[0-9]+}/delete", mw.CheckAuthMiddleware(ch.DeleteClub)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/clubs/{id:[0-9]+}/complain", mw.CheckAuthMiddleware(ch.ComplainClub)).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/cars", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(ch.GetClubsCars))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/events", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(ch.GetClubsEvents))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/chat_link", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubViewChat, "id", ch.GetClubChatLink))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/delete", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubDelete, "id", ch.DeleteClub))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/complain", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassComplaints, ch.ComplainClub))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/moderators", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(ch.GetClubModerators))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/moderators/{uid:[0-9]+}", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubManageModerators, "id", ch.PromoteModerator))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/moderators/{uid:[0-9]+}", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubManageModerators, "id", ch.DemoteModerator))).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/complaints", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubHandleComplaints, "id", ch.GetClubComplaints))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/complaints/{complaint_id:[0-9]+}/dismiss", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubHandleComplaints, "id", ch.DismissClubComplaint))).Methods(http.MethodPost, http.MethodOptions)
+}
+
+// ConfigureV2 registers the v2 routes: plural resources, REST verbs instead of /delete and /leave,
+// and the member lists and requests as collections of their own.
+func (ch *ClubsHandler) ConfigureV2(r *mux.Router, mw *middleware.Middleware) {
+	r.HandleFunc("/clubs", mw.CheckAuthMiddleware(mw.Idempotent(ch.CreateClubV2))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/clubs", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(ch.GetClubs))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/tags", middleware.ETag(middleware.CacheShort, mw.CheckAuthMiddleware(ch.GetTags))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(ch.GetClubByID))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubUpdate, "id", ch.UpdateClub))).Methods(http.MethodPut, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubDelete, "id", ch.DeleteClub))).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/avatar", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassUploads, mw.RequirePermission(authorization.ActionClubUpdate, "id", ch.UploadAvatarHandler)))).Methods(http.MethodPut, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/participants", middleware.ETag(middleware.CacheRevalidate, middleware.WithVars(map[string]string{"type": "participant"}, mw.CheckAuthMiddleware(ch.GetClubsUsersByType)))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/subscribers", middleware.ETag(middleware.CacheRevalidate, middleware.WithVars(map[string]string{"type": "subscriber"}, mw.CheckAuthMiddleware(ch.GetClubsUsersByType)))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/subscribers", middleware.WithVars(map[string]string{"type": "subscribe"}, mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassParticipation, ch.SetUserStatusByClubID)))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/participant_requests", middleware.ETag(middleware.CacheRevalidate, middleware.WithVars(map[string]string{"type": "participant_request"}, mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubViewRequests, "id", ch.GetClubsUsersByType))))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/participant_requests", middleware.WithVars(map[string]string{"type": "participate"}, mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassParticipation, ch.SetUserStatusByClubID)))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/clubs/{cid:[0-9]+}/participant_requests/{uid:[0-9]+}", middleware.WithVars(map[string]string{"type": "reject"}, mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubManageParticipants, "cid", ch.ApproveRejectUserParticipateInClub)))).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/clubs/{cid:[0-9]+}/participants/{uid:[0-9]+}", middleware.WithVars(map[string]string{"type": "approve"}, mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubManageParticipants, "cid", ch.ApproveRejectUserParticipateInClub)))).Methods(http.MethodPut, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/membership", mw.CheckAuthMiddleware(ch.LeaveClub)).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/cars", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(ch.GetClubsCars))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/events", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(ch.GetClubsEvents))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/chat_link", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubViewChat, "id", ch.GetClubChatLink))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/moderators", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(ch.GetClubModerators))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/moderators/{uid:[0-9]+}", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubManageModerators, "id", ch.PromoteModerator))).Methods(http.MethodPut, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/moderators/{uid:[0-9]+}", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubManageModerators, "id", ch.DemoteModerator))).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/complaints", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassComplaints, ch.ComplainClub))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/complaints", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubHandleComplaints, "id", ch.GetClubComplaints))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/clubs/{id:[0-9]+}/complaints/{complaint_id:[0-9]+}", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionClubHandleComplaints, "id", ch.DismissClubComplaint))).Methods(http.MethodDelete, http.MethodOptions)
 }
 
 // CreateClub godoc
@@ -50,28 +92,63 @@ func (ch *ClubsHandler) Configure(r *mux.Router, mw *middleware.Middleware) {
 // @Accept       json
 // @Produce      json
 // @Param        body body models.CreateClubRequest true "Club"
+// @Param        Idempotency-Key header string false "Replays the first response to a retry with the same key"
 // @Success      200  {object}  models.Club
 // @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /club/create [post]
 func (ch *ClubsHandler) CreateClub(w http.ResponseWriter, r *http.Request) {
+	clubsData, ok := ch.createClub(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := json.Marshal(clubsData)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// CreateClubV2 is CreateClub of API v2: it answers 201 with the new club in the Location header.
+func (ch *ClubsHandler) CreateClubV2(w http.ResponseWriter, r *http.Request) {
+	clubsData, ok := ch.createClub(w, r)
+	if !ok {
+		return
+	}
+
+	utils.WriteCreated(w, r, r.URL.Path+"/"+strconv.FormatUint(clubsData.ID, 10), clubsData)
+}
+
+// createClub creates the club from the request body. On failure it writes the error and returns false.
+func (ch *ClubsHandler) createClub(w http.ResponseWriter, r *http.Request) (*models.Club, bool) {
 	defer r.Body.Close()
 
 	// добавить проверку авторизации
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
-		return
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+		return nil, false
 	}
 
 	club := &models.CreateClubRequest{}
 	err := json.NewDecoder(r.Body).Decode(&club)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't unmarshal data"}))
-		return
+		utils.WriteError(w, r, apperrors.Validation("can't unmarshal data"))
+		return nil, false
+	}
+
+	err = club.Validate()
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
 	clubsData := &models.Club{
@@ -86,36 +163,23 @@ func (ch *ClubsHandler) CreateClub(w http.ResponseWriter, r *http.Request) {
 
 	err = ch.clubsUcase.CreateClub(clubsData)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
-	id, err := ch.vk.CreatChat(clubsData.Name)
+	id, err := ch.vk.CreatChat(r.Context(), clubsData.Name)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
 	err = ch.clubsUcase.SetClubChatID(int64(clubsData.ID), int64(id))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
-	}
-
-	body, err := json.Marshal(clubsData)
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
-		return
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-
-	w.WriteHeader(http.StatusOK)
-	w.Write(body)
+	return clubsData, true
 }
 
 // GetClubs godoc
@@ -124,11 +188,10 @@ func (ch *ClubsHandler) CreateClub(w http.ResponseWriter, r *http.Request) {
 // @Tags         Clubs
 // @Accept       json
 // @Produce      json
-// @Param        IdGt query integer false "IdGt"
-// @Param        IdLte query integer false "IdLte"
+// @Param        Cursor query string false "Cursor"
 // @Param        Limit query integer false "Limit"
 // @Param        Query query string false "Query"
-// @Success      200  {object}  []models.ClubCard
+// @Success      200  {object}  pagination.Response{items=[]models.ClubCard}
 // @Failure      400  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
@@ -139,15 +202,19 @@ func (ch *ClubsHandler) GetClubs(w http.ResponseWriter, r *http.Request) {
 	decoder.IgnoreUnknownKeys(true)
 	err := decoder.Decode(query, r.URL.Query())
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
+		return
+	}
+
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	clubs, err := ch.clubsUcase.GetClubs(query.IdGt, query.IdLte, query.Limit, query.Query)
+	clubs, next, err := ch.clubsUcase.GetClubs(page, query.Query)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 	if len(clubs) == 0 {
@@ -166,10 +233,9 @@ func (ch *ClubsHandler) GetClubs(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
-	body, err := json.Marshal(clubCards)
+	body, err := json.Marshal(pagination.NewResponse(clubCards, next))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -202,15 +268,13 @@ func (ch *ClubsHandler) GetClubByID(w http.ResponseWriter, r *http.Request) {
 
 	club, err := ch.clubsUcase.GetClubByID(clubID, userID)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	body, err := json.Marshal(club)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -229,6 +293,8 @@ func (ch *ClubsHandler) GetClubByID(w http.ResponseWriter, r *http.Request) {
 // @Param 		 file-upload formData file true "Image to upload"
 // @Success      200  {object}  models.Club
 // @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /clubs/{id}/upload [post]
@@ -239,51 +305,117 @@ func (ch *ClubsHandler) UploadAvatarHandler(w http.ResponseWriter, r *http.Reque
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	r.Body = http.MaxBytesReader(w, r.Body, 3*1024*1024)
 	err := r.ParseMultipartForm(3 * 1024 * 1024)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't parse data"}))
+		utils.WriteError(w, r, apperrors.Validation("can't parse data"))
 		return
 	}
 
 	if len(r.MultipartForm.File["file-upload"]) == 0 {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "no photo"}))
+		utils.WriteError(w, r, apperrors.Validation("no photo"))
 		return
 	}
 
 	file := r.MultipartForm.File["file-upload"][0]
 	club, err := ch.clubsUcase.UpdateAvatar(clubID, file)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	chatID, err := ch.clubsUcase.GetClubChatID(clubID, int64(userID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	err = ch.vk.UploadChatPhoto(int(chatID), file)
+	err = ch.vk.UploadChatPhoto(r.Context(), int(chatID), file)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	body, err := json.Marshal(club)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// UpdateClub godoc
+// @Summary      update a club
+// @Description  Handler for changing the name, description and tags of a club. Only the fields in the body change; a new name renames the VK chat of the club too
+// @Tags         Clubs
+// @Accept       json
+// @Produce      json
+// @Param        id path int64 true "Club ID"
+// @Param        body body models.UpdateClubRequest true "Club"
+// @Success      200  {object}  models.Club
+// @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
+// @Failure      404  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /clubs/{id} [put]
+func (ch *ClubsHandler) UpdateClub(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	vars := mux.Vars(r)
+	clubID, _ := strconv.ParseInt(vars["id"], 10, 64)
+
+	userID, ok := r.Context().Value("userID").(uint64)
+	if !ok {
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+		return
+	}
+
+	update := &models.UpdateClubRequest{}
+	err := json.NewDecoder(r.Body).Decode(update)
+	if err != nil {
+		utils.WriteError(w, r, apperrors.Validation("can't unmarshal data"))
+		return
+	}
+
+	err = update.Validate()
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	club, err := ch.clubsUcase.UpdateClub(clubID, update)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	// The club is saved even if VK fails; repeating the request renames the chat again.
+	if update.Name != nil {
+		chatID, err := ch.clubsUcase.GetClubChatID(clubID, int64(userID))
+		if err != nil {
+			utils.WriteError(w, r, err)
+			return
+		}
+
+		if chatID != 0 {
+			err = ch.vk.EditChat(r.Context(), int(chatID), club.Name)
+			if err != nil {
+				utils.WriteError(w, r, err)
+				return
+			}
+		}
+	}
+
+	body, err := json.Marshal(club)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -306,8 +438,7 @@ func (ch *ClubsHandler) UploadAvatarHandler(w http.ResponseWriter, r *http.Reque
 func (ch *ClubsHandler) GetTags(w http.ResponseWriter, r *http.Request) {
 	tags, err := ch.clubsUcase.GetTags()
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 	if len(tags) == 0 {
@@ -316,8 +447,7 @@ func (ch *ClubsHandler) GetTags(w http.ResponseWriter, r *http.Request) {
 
 	body, err := json.Marshal(tags)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -333,12 +463,13 @@ func (ch *ClubsHandler) GetTags(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Param        id path int64 true "Club ID"
-// @Param        IdGt query integer false "IdGt"
-// @Param        IdLte query integer false "IdLte"
+// @Param        Cursor query string false "Cursor"
 // @Param        Limit query integer false "Limit"
 // @Param        type path string true "Type" Enums(participant, participant_request, subscriber)
-// @Success      200  {object}  []models.UserCard
+// @Success      200  {object}  pagination.Response{items=[]models.UserCard}
 // @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /clubs/{id}/{type} [get]
@@ -347,52 +478,33 @@ func (ch *ClubsHandler) GetClubsUsersByType(w http.ResponseWriter, r *http.Reque
 	clubID, _ := strconv.ParseUint(vars["id"], 10, 64)
 	role := vars["type"]
 
-	if role == "participant_request" {
-		userID, ok := r.Context().Value("userID").(uint64)
-		if !ok {
-			w.WriteHeader(http.StatusUnauthorized)
-			w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
-			return
-		}
-
-		userClubSatus, err := ch.clubsUcase.GetUserStatusInClub(int64(clubID), int64(userID))
-		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-			return
-		}
-
-		if userClubSatus == nil || userClubSatus.Status != "admin" {
-			w.WriteHeader(http.StatusBadRequest)
-			w.Write(utils.JSONError(&utils.Error{Message: "user has inappropriate status"}))
-			return
-		}
-	}
-
 	query := &models.ClubQuery{}
 	decoder := schema.NewDecoder()
 	decoder.IgnoreUnknownKeys(true)
 	err := decoder.Decode(query, r.URL.Query())
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
 		return
 	}
 
-	users, err := ch.clubsUcase.GetClubsUserByStatus(int64(clubID), role, query.IdGt, query.IdLte, query.Limit)
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	users, next, err := ch.clubsUcase.GetClubsUserByStatus(int64(clubID), role, page)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 	if len(users) == 0 {
 		users = []*models.UserCard{}
 	}
 
-	body, err := json.Marshal(users)
+	body, err := json.Marshal(pagination.NewResponse(users, next))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -408,10 +520,9 @@ func (ch *ClubsHandler) GetClubsUsersByType(w http.ResponseWriter, r *http.Reque
 // @Accept       json
 // @Produce      json
 // @Param        id path int64 true "Club ID"
-// @Param        IdGt query integer false "IdGt"
-// @Param        IdLte query integer false "IdLte"
+// @Param        Cursor query string false "Cursor"
 // @Param        Limit query integer false "Limit"
-// @Success      200  {object}  []models.CarCard
+// @Success      200  {object}  pagination.Response{items=[]models.CarCard}
 // @Failure      400  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
@@ -425,25 +536,28 @@ func (ch *ClubsHandler) GetClubsCars(w http.ResponseWriter, r *http.Request) {
 	decoder.IgnoreUnknownKeys(true)
 	err := decoder.Decode(query, r.URL.Query())
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
 		return
 	}
 
-	cars, err := ch.clubsUcase.GetClubsCars(int64(clubID), query.IdGt, query.IdLte, query.Limit)
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	cars, next, err := ch.clubsUcase.GetClubsCars(int64(clubID), page)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 	if len(cars) == 0 {
 		cars = []*models.CarCard{}
 	}
 
-	body, err := json.Marshal(cars)
+	body, err := json.Marshal(pagination.NewResponse(cars, next))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -459,10 +573,9 @@ func (ch *ClubsHandler) GetClubsCars(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Param        id path int64 true "Club ID"
-// @Param        IdGt query integer false "IdGt"
-// @Param        IdLte query integer false "IdLte"
+// @Param        Cursor query string false "Cursor"
 // @Param        Limit query integer false "Limit"
-// @Success      200  {object}  []models.EventCard
+// @Success      200  {object}  pagination.Response{items=[]models.EventCard}
 // @Failure      400  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
@@ -476,25 +589,28 @@ func (ch *ClubsHandler) GetClubsEvents(w http.ResponseWriter, r *http.Request) {
 	decoder.IgnoreUnknownKeys(true)
 	err := decoder.Decode(query, r.URL.Query())
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
 		return
 	}
 
-	events, err := ch.clubsUcase.GetClubsEvents(int64(clubID), query.IdGt, query.IdLte, query.Limit)
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	events, next, err := ch.clubsUcase.GetClubsEvents(int64(clubID), page)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 	if len(events) == 0 {
 		events = []*models.EventCard{}
 	}
 
-	body, err := json.Marshal(events)
+	body, err := json.Marshal(pagination.NewResponse(events, next))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -513,7 +629,7 @@ func (ch *ClubsHandler) GetClubsEvents(w http.ResponseWriter, r *http.Request) {
 // @Param        type path string true "Type" Enums(participate, subscribe)
 // @Success      200
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /clubs/{id}/{type} [post]
@@ -524,21 +640,18 @@ func (ch *ClubsHandler) SetUserStatusByClubID(w http.ResponseWriter, r *http.Req
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	userClubSatus, err := ch.clubsUcase.GetUserStatusInClub(int64(clubID), int64(userID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	if decision == "participate" && userClubSatus != nil && (userClubSatus.Status == "participant" || userClubSatus.Status == "admin" || userClubSatus.Status == "moderator") {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "user has inappropriate status"}))
+		utils.WriteError(w, r, apperrors.Validation("user has inappropriate status"))
 		return
 	}
 
@@ -549,26 +662,23 @@ func (ch *ClubsHandler) SetUserStatusByClubID(w http.ResponseWriter, r *http.Req
 
 	err = ch.clubsUcase.SetUserStatusByClubID(int64(clubID), int64(userID), status)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	if status == "participant_request" {
 		club, err := ch.clubsUcase.GetClubByID(clubID, userID)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+			utils.WriteError(w, r, err)
 			return
 		}
 
-		clubUrl := "https://vk.com/app8099557"
-		err = ch.vk.CreatMessage(int(club.Owner.VKID),
+		clubUrl := ch.appURL
+		err = ch.vk.CreatMessage(r.Context(), int(club.Owner.VKID),
 			fmt.Sprintf("Привет! Новый пользователь хочет поучаствовать в %s: %s\n", club.Name, clubUrl),
 		)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+			utils.WriteError(w, r, err)
 			return
 		}
 	}
@@ -585,7 +695,7 @@ func (ch *ClubsHandler) SetUserStatusByClubID(w http.ResponseWriter, r *http.Req
 // @Param        id path int64 true "Club ID"
 // @Success      200
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /clubs/{id}/leave [post]
@@ -595,28 +705,24 @@ func (ch *ClubsHandler) LeaveClub(w http.ResponseWriter, r *http.Request) {
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	userClubSatus, err := ch.clubsUcase.GetUserStatusInClub(int64(clubID), int64(userID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	if userClubSatus == nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "user has inappropriate status"}))
+		utils.WriteError(w, r, apperrors.Validation("user has inappropriate status"))
 		return
 	}
 
 	err = ch.clubsUcase.DeleteUserFromClub(int64(clubID), int64(userID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -634,8 +740,10 @@ func (ch *ClubsHandler) LeaveClub(w http.ResponseWriter, r *http.Request) {
 // @Param        type path string true "Type" Enums(approve, reject)
 // @Success      200
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
+// @Failure      409  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /clubs/{cid}/participate/{uid}/{type} [post]
 func (ch *ClubsHandler) ApproveRejectUserParticipateInClub(w http.ResponseWriter, r *http.Request) {
@@ -644,51 +752,44 @@ func (ch *ClubsHandler) ApproveRejectUserParticipateInClub(w http.ResponseWriter
 	userID, _ := strconv.ParseUint(vars["uid"], 10, 64)
 	decision := vars["type"]
 
-	ownerID, ok := r.Context().Value("userID").(uint64)
-	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+	userClubSatus, err := ch.clubsUcase.GetUserStatusInClub(int64(clubID), int64(userID))
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	userClubSatus, err := ch.clubsUcase.GetUserStatusInClub(int64(clubID), int64(ownerID))
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+	if userClubSatus == nil {
+		utils.WriteError(w, r, clubs.ErrNotClubMember)
 		return
 	}
 
-	if userClubSatus == nil || userClubSatus.Status != "admin" {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "user has inappropriate status"}))
+	if userClubSatus.Status != "participant_request" {
+		utils.WriteError(w, r, apperrors.Validation("user has inappropriate status"))
 		return
 	}
 
 	err = ch.clubsUcase.ApproveRejectUserParticipateInClub(int64(clubID), int64(userID), decision)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	club, err := ch.clubsUcase.GetClubByID(clubID, userID)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	clubUrl := "https://vk.com/app8099557"
+	clubUrl := ch.appURL
 
 	msg := fmt.Sprintf("Привет! Администратор принял вас в %s: %s\n", club.Name, clubUrl)
 	if decision == "reject" {
 		msg = fmt.Sprintf("Привет! К сожалению, администратор отклонил ваш запрос на участие в %s: %s\n", club.Name, clubUrl)
 	}
 
-	err = ch.vk.CreatMessage(int(userID), msg)
+	err = ch.vk.CreatMessage(r.Context(), int(userID), msg)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -704,7 +805,8 @@ func (ch *ClubsHandler) ApproveRejectUserParticipateInClub(w http.ResponseWriter
 // @Param        id path int64 true "Club ID"
 // @Success      200  {object}  models.ChatLink
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /clubs/{id}/chat_link [get]
@@ -714,48 +816,30 @@ func (ch *ClubsHandler) GetClubChatLink(w http.ResponseWriter, r *http.Request)
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
-		return
-	}
-
-	userClubSatus, err := ch.clubsUcase.GetUserStatusInClub(int64(clubID), int64(userID))
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
-	}
-
-	if userClubSatus == nil || (userClubSatus.Status != "admin" && userClubSatus.Status != "participant") {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "user has inappropriate status"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	chatID, err := ch.clubsUcase.GetClubChatID(int64(clubID), int64(userID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	if chatID == 0 {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "no chat for this club"}))
+		utils.WriteError(w, r, apperrors.Validation("no chat for this club"))
 		return
 	}
 
-	chatLink, err := ch.vk.GetChatLink(int(chatID))
+	chatLink, err := ch.vk.GetChatLink(r.Context(), int(chatID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	body, err := json.Marshal(models.ChatLink{ChatLink: chatLink})
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -774,6 +858,7 @@ func (ch *ClubsHandler) GetClubChatLink(w http.ResponseWriter, r *http.Request)
 // @Success      200
 // @Failure      400  {object}  utils.Error
 // @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /clubs/{id}/delete [post]
@@ -781,30 +866,9 @@ func (ch *ClubsHandler) DeleteClub(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	clubID, _ := strconv.ParseUint(vars["id"], 10, 64)
 
-	userID, ok := r.Context().Value("userID").(uint64)
-	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
-		return
-	}
-
-	club, err := ch.clubsUcase.GetClubByID(clubID, userID)
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
-	}
-
-	if club.Owner.VKID != userID {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "user has inappropriate status"}))
-		return
-	}
-
-	err = ch.clubsUcase.DeleteClubByID(int64(clubID))
+	err := ch.clubsUcase.DeleteClubByID(int64(clubID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -832,16 +896,20 @@ func (ch *ClubsHandler) ComplainClub(w http.ResponseWriter, r *http.Request) {
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	req := &models.ComplaintReq{}
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't unmarshal data"}))
+		utils.WriteError(w, r, apperrors.Validation("can't unmarshal data"))
+		return
+	}
+
+	err = req.Validate()
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -851,10 +919,217 @@ func (ch *ClubsHandler) ComplainClub(w http.ResponseWriter, r *http.Request) {
 		TargetID: int64(clubID),
 	})
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+// GetClubModerators godoc
+// @Summary      get club moderators
+// @Description  Handler for getting club moderators list
+// @Tags         Clubs
+// @Accept       json
+// @Produce      json
+// @Param        id path int64 true "Club ID"
+// @Param        Cursor query string false "Cursor"
+// @Param        Limit query integer false "Limit"
+// @Success      200  {object}  pagination.Response{items=[]models.UserCard}
+// @Failure      400  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /clubs/{id}/moderators [get]
+func (ch *ClubsHandler) GetClubModerators(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	clubID, _ := strconv.ParseUint(vars["id"], 10, 64)
+
+	query := &models.ClubQuery{}
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	err := decoder.Decode(query, r.URL.Query())
+	if err != nil {
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
+		return
+	}
+
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	users, next, err := ch.clubsUcase.GetClubsUserByStatus(int64(clubID), "moderator", page)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+	if len(users) == 0 {
+		users = []*models.UserCard{}
+	}
+
+	body, err := json.Marshal(pagination.NewResponse(users, next))
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-}
\ No newline at end of file
+	w.Write(body)
+}
+
+// PromoteModerator godoc
+// @Summary      promote moderator
+// @Description  Handler for making a club participant a moderator. Only the club admin can do it
+// @Tags         Clubs
+// @Accept       json
+// @Produce      json
+// @Param        id path int64 true "Club ID"
+// @Param        uid path int64 true "User ID"
+// @Success      200
+// @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
+// @Failure      404  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /clubs/{id}/moderators/{uid} [post]
+func (ch *ClubsHandler) PromoteModerator(w http.ResponseWriter, r *http.Request) {
+	ch.changeModeratorStatus(w, r, "participant", ch.clubsUcase.PromoteModerator)
+}
+
+// DemoteModerator godoc
+// @Summary      demote moderator
+// @Description  Handler for making a club moderator a regular participant again. Only the club admin can do it
+// @Tags         Clubs
+// @Accept       json
+// @Produce      json
+// @Param        id path int64 true "Club ID"
+// @Param        uid path int64 true "User ID"
+// @Success      200
+// @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
+// @Failure      404  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /clubs/{id}/moderators/{uid} [delete]
+func (ch *ClubsHandler) DemoteModerator(w http.ResponseWriter, r *http.Request) {
+	ch.changeModeratorStatus(w, r, "moderator", ch.clubsUcase.DemoteModerator)
+}
+
+func (ch *ClubsHandler) changeModeratorStatus(w http.ResponseWriter, r *http.Request, requiredStatus string, change func(clubID int64, userID int64) error) {
+	vars := mux.Vars(r)
+	clubID, _ := strconv.ParseInt(vars["id"], 10, 64)
+	userID, _ := strconv.ParseInt(vars["uid"], 10, 64)
+
+	userClubSatus, err := ch.clubsUcase.GetUserStatusInClub(clubID, userID)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	if userClubSatus == nil {
+		utils.WriteError(w, r, apperrors.NotFound("user is not a member of the club"))
+		return
+	}
+
+	if userClubSatus.Status != requiredStatus {
+		utils.WriteError(w, r, apperrors.Validation("user has inappropriate status"))
+		return
+	}
+
+	err = change(clubID, userID)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetClubComplaints godoc
+// @Summary      get club complaints
+// @Description  Handler for getting open complaints about the club, its events and posts in its events. Available to the club admin and moderators
+// @Tags         Clubs
+// @Accept       json
+// @Produce      json
+// @Param        id path int64 true "Club ID"
+// @Param        Cursor query string false "Cursor"
+// @Param        Limit query integer false "Limit"
+// @Success      200  {object}  pagination.Response{items=[]models.ComplaintCard}
+// @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
+// @Failure      404  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /clubs/{id}/complaints [get]
+func (ch *ClubsHandler) GetClubComplaints(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	clubID, _ := strconv.ParseInt(vars["id"], 10, 64)
+
+	query := &models.ComplaintQuery{}
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	err := decoder.Decode(query, r.URL.Query())
+	if err != nil {
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
+		return
+	}
+
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	complaints, next, err := ch.clubsUcase.GetClubComplaints(clubID, page)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+	if len(complaints) == 0 {
+		complaints = []*models.ComplaintCard{}
+	}
+
+	body, err := json.Marshal(pagination.NewResponse(complaints, next))
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// DismissClubComplaint godoc
+// @Summary      dismiss club complaint
+// @Description  Handler for dismissing a complaint about the club, its events or posts. Available to the club admin and moderators
+// @Tags         Clubs
+// @Accept       json
+// @Produce      json
+// @Param        id path int64 true "Club ID"
+// @Param        complaint_id path int64 true "Complaint ID"
+// @Success      200
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
+// @Failure      404  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /clubs/{id}/complaints/{complaint_id}/dismiss [post]
+func (ch *ClubsHandler) DismissClubComplaint(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	clubID, _ := strconv.ParseInt(vars["id"], 10, 64)
+	complaintID, _ := strconv.ParseInt(vars["complaint_id"], 10, 64)
+
+	userID, ok := r.Context().Value("userID").(uint64)
+	if !ok {
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+		return
+	}
+
+	err := ch.clubsUcase.DismissClubComplaint(clubID, complaintID, int64(userID))
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
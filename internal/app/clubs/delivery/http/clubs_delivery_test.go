@@ -0,0 +1,120 @@
+package delivery
+
+import (
+	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+	"github.com/dantedoyl/car-life-api/internal/app/clients/vk"
+	clubs "github.com/dantedoyl/car-life-api/internal/app/clubs"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/gorilla/mux"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+const (
+	clubID       = 1
+	adminID      = 10
+	moderatorID  = 11
+	memberID     = 12
+	subscriberID = 13
+	requesterID  = 14
+	strangerID   = 15
+)
+
+// fakeClubsUsecase serves the statuses of one club and records the decisions it is asked for.
+// The other methods of clubs.IClubsUsecase are not used by the tests and panic.
+type fakeClubsUsecase struct {
+	clubs.IClubsUsecase
+	statuses  map[int64]string
+	decisions []string
+}
+
+func (fc *fakeClubsUsecase) GetUserStatusInClub(id int64, userID int64) (*models.ClubUser, error) {
+	status, ok := fc.statuses[userID]
+	if !ok {
+		return nil, nil
+	}
+	return &models.ClubUser{ClubID: id, UserID: userID, Status: status}, nil
+}
+
+func (fc *fakeClubsUsecase) ApproveRejectUserParticipateInClub(id int64, userID int64, decision string) error {
+	fc.decisions = append(fc.decisions, decision)
+	return nil
+}
+
+func (fc *fakeClubsUsecase) GetClubByID(id uint64, userID uint64) (*models.Club, error) {
+	return &models.Club{ID: id, Name: "Club", Owner: models.UserCard{VKID: adminID}}, nil
+}
+
+// fakeVK counts the messages sent to the users.
+type fakeVK struct {
+	vk.Client
+	messages int
+}
+
+func (fv *fakeVK) CreatMessage(ctx context.Context, userID int, message string) error {
+	fv.messages++
+	return nil
+}
+
+func TestApproveRejectUserParticipateInClub(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   uint64
+		decision string
+		want     int
+	}{
+		{"moderator rejects the admin", adminID, "reject", http.StatusBadRequest},
+		{"moderator approves the admin", adminID, "approve", http.StatusBadRequest},
+		{"moderator rejects another moderator", moderatorID, "reject", http.StatusBadRequest},
+		{"moderator approves a participant again", memberID, "approve", http.StatusBadRequest},
+		{"moderator approves a subscriber who never asked", subscriberID, "approve", http.StatusBadRequest},
+		{"moderator decides on a stranger", strangerID, "approve", http.StatusNotFound},
+		{"moderator approves a request", requesterID, "approve", http.StatusOK},
+		{"moderator rejects a request", requesterID, "reject", http.StatusOK},
+	}
+
+	// The moderator gets past RequirePermission, so the handler is what has to stop them.
+	if !authorization.Allowed(authorization.ActionClubManageParticipants, []authorization.Role{authorization.RoleClubModerator}) {
+		t.Fatal("moderators are expected to manage participants")
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ucase := &fakeClubsUsecase{statuses: map[int64]string{
+				adminID:      "admin",
+				moderatorID:  "moderator",
+				memberID:     "participant",
+				subscriberID: "subscriber",
+				requesterID:  "participant_request",
+			}}
+			vkClient := &fakeVK{}
+			handler := NewClubsHandler(ucase, vkClient, "https://vk.com/app1")
+
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			r = r.WithContext(context.WithValue(r.Context(), "userID", uint64(moderatorID)))
+			r = mux.SetURLVars(r, map[string]string{
+				"cid":  strconv.Itoa(clubID),
+				"uid":  strconv.FormatUint(tt.target, 10),
+				"type": tt.decision,
+			})
+			rec := httptest.NewRecorder()
+			handler.ApproveRejectUserParticipateInClub(rec, r)
+
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.want, rec.Body.String())
+			}
+			if tt.want != http.StatusOK {
+				if len(ucase.decisions) != 0 || vkClient.messages != 0 {
+					t.Fatalf("decisions = %v, messages = %d, want none", ucase.decisions, vkClient.messages)
+				}
+				return
+			}
+			if len(ucase.decisions) != 1 || ucase.decisions[0] != tt.decision || vkClient.messages != 1 {
+				t.Fatalf("decisions = %v, messages = %d, want one %s and one message", ucase.decisions, vkClient.messages, tt.decision)
+			}
+		})
+	}
+}
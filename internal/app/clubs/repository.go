@@ -1,21 +1,29 @@
 package events
 
-import "github.com/dantedoyl/car-life-api/internal/app/models"
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+)
 
 type IClubsRepository interface {
 	InsertClub(event *models.Club) error
 	GetClubByID(id int64, userID uint64) (*models.Club, error)
-	GetClubs(idGt *uint64, idLte *uint64, limit *uint64, query *string) ([]*models.Club, error)
+	GetClubsByIDs(ids []uint64, userID uint64) ([]*models.Club, error)
+	GetClubs(page pagination.Page, query *string) ([]*models.Club, *pagination.Cursor, error)
 	UpdateClub(event *models.Club) (*models.Club, error)
 	GetTags() ([]models.Tag, error)
-	GetClubsUserByStatus(club_id int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.UserCard, error)
-	GetClubsCars(club_id int64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.CarCard, error)
-	GetClubsEvents(club_id int64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.EventCard, error)
+	GetClubsUserByStatus(club_id int64, status string, page pagination.Page) ([]*models.UserCard, *pagination.Cursor, error)
+	GetClubsCars(club_id int64, page pagination.Page) ([]*models.CarCard, *pagination.Cursor, error)
+	GetClubsEvents(club_id int64, page pagination.Page) ([]*models.EventCard, *pagination.Cursor, error)
 	SetUserStatusByClubID(clubID int64, userID int64, status string) error
 	GetUserStatusInClub(clubID int64, userID int64) (*models.ClubUser, error)
+	DecideParticipantRequest(clubID int64, userID int64, status string) error
 	SetClubChatID(clubID int64, chatID int64) error
 	GetClubChatID(clubID int64, userID int64) (int64, error)
 	DeleteUserFromClub(clubID int64, userID int64) error
 	DeleteClubByID(clubID int64) error
 	ComplainByID(complaint models.Complaint) error
+	UpdateUserStatusInClub(clubID int64, userID int64, status string) error
+	GetClubComplaints(clubID int64, page pagination.Page) ([]*models.ComplaintCard, *pagination.Cursor, error)
+	DismissClubComplaint(clubID int64, complaintID int64, handledBy int64) error
 }
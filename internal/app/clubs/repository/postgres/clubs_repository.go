@@ -2,10 +2,13 @@ package clubs_repository
 
 import (
 	"database/sql"
+	"github.com/dantedoyl/car-life-api/internal/app/clients/database"
 	clubs "github.com/dantedoyl/car-life-api/internal/app/clubs"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"github.com/lib/pq"
 	"strconv"
+	"time"
 )
 
 type ClubsRepository struct {
@@ -23,10 +26,10 @@ func (cr *ClubsRepository) InsertClub(club *models.Club) error {
 		`INSERT INTO clubs
                 (name, description, tags, participants_count)
                 VALUES ($1, $2, $3, 1) 
-                RETURNING id`,
+                RETURNING id, updated_at`,
 		club.Name,
 		club.Description,
-		pq.Array(club.Tags)).Scan(&club.ID)
+		pq.Array(club.Tags)).Scan(&club.ID, &club.UpdatedAt)
 	if err != nil {
 		return err
 	}
@@ -59,8 +62,11 @@ func (cr *ClubsRepository) InsertClub(club *models.Club) error {
 func (cr *ClubsRepository) GetClubByID(id int64, userID uint64) (*models.Club, error) {
 	club := &models.Club{}
 	err := cr.dbConn.QueryRow(
-		`SELECT  c.id, c.name, c.description, c.tags, c.events_count, c.participants_count, c.avatar, uc.user_id as owner_id, c.participants_count, c.subscribers_count from clubs as c inner join users_clubs as uc on uc.club_id = c.id
-				WHERE c.id = $1 and uc.status = 'admin'`, id).Scan(&club.ID, &club.Name, &club.Description, pq.Array(&club.Tags), &club.EventsCount, &club.ParticipantsCount, &club.AvatarUrl, &club.Owner.VKID, &club.ParticipantsCount, &club.SubscribersCount)
+		`SELECT  c.id, c.name, c.description, c.tags, c.events_count, c.participants_count, c.avatar, uc.user_id as owner_id, c.participants_count, c.subscribers_count, c.updated_at from clubs as c inner join users_clubs as uc on uc.club_id = c.id
+				WHERE c.id = $1 and uc.status = 'admin' and `+database.Visible("c", 2), id, userID).Scan(&club.ID, &club.Name, &club.Description, pq.Array(&club.Tags), &club.EventsCount, &club.ParticipantsCount, &club.AvatarUrl, &club.Owner.VKID, &club.ParticipantsCount, &club.SubscribersCount, &club.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, clubs.ErrClubNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -90,23 +96,43 @@ func (cr *ClubsRepository) GetClubByID(id int64, userID uint64) (*models.Club, e
 	return club, nil
 }
 
-func (cr *ClubsRepository) GetClubs(idGt *uint64, idLte *uint64, limit *uint64, query *string) ([]*models.Club, error) {
-	var clubs []*models.Club
-	ind := 1
-	var values []interface{}
-	q := `SELECT  id, name, description, tags, events_count, participants_count, avatar, participants_count, subscribers_count from clubs WHERE true `
-
-	if idGt != nil {
-		q += ` AND id > $` + strconv.Itoa(ind)
-		values = append(values, idGt)
-		ind++
+// GetClubsByIDs loads what GetClubByID does with one query for all the clubs.
+// The status of a user with no relation to a club is "unknown", like in GetClubByID.
+func (cr *ClubsRepository) GetClubsByIDs(ids []uint64, userID uint64) ([]*models.Club, error) {
+	rows, err := cr.dbConn.Query(
+		`SELECT c.id, c.name, c.description, c.tags, c.events_count, c.participants_count, c.avatar, c.subscribers_count, c.updated_at,
+       			u.vk_id, u.name, u.surname, u.avatar, COALESCE(s.status, 'unknown') from clubs as c
+				inner join users_clubs as uc on uc.club_id = c.id and uc.status = 'admin'
+				inner join users as u on u.vk_id = uc.user_id
+				left join users_clubs as s on s.club_id = c.id and s.user_id = $2
+				WHERE c.id = ANY($1) and `+database.Visible("c", 2), pq.Array(ids), userID)
+	if err != nil {
+		return nil, err
 	}
 
-	if idLte != nil {
-		q += ` AND id <= $` + strconv.Itoa(ind)
-		values = append(values, idLte)
-		ind++
+	defer rows.Close()
+
+	var found []*models.Club
+	for rows.Next() {
+		club := &models.Club{}
+		err = rows.Scan(&club.ID, &club.Name, &club.Description, pq.Array(&club.Tags), &club.EventsCount, &club.ParticipantsCount, &club.AvatarUrl, &club.SubscribersCount, &club.UpdatedAt,
+			&club.Owner.VKID, &club.Owner.Name, &club.Owner.Surname, &club.Owner.AvatarUrl, &club.UserStatus)
+		if err != nil {
+			return nil, err
+		}
+		if userID == 0 {
+			club.UserStatus = ""
+		}
+		found = append(found, club)
 	}
+	return found, rows.Err()
+}
+
+func (cr *ClubsRepository) GetClubs(page pagination.Page, query *string) ([]*models.Club, *pagination.Cursor, error) {
+	var clubs []*models.Club
+	ind := 1
+	var values []interface{}
+	q := `SELECT  id, name, description, tags, events_count, participants_count, avatar, participants_count, subscribers_count, created_at from clubs WHERE hidden = false `
 
 	if query != nil {
 		q += ` AND (lower(name) like '%' || lower($` + strconv.Itoa(ind) + `) || '%' OR EXISTS (
@@ -117,42 +143,104 @@ func (cr *ClubsRepository) GetClubs(idGt *uint64, idLte *uint64, limit *uint64,
 		ind++
 	}
 
-	if limit != nil {
-		q += ` LIMIT $` + strconv.Itoa(ind)
-		values = append(values, limit)
+	if page.After != nil {
+		createdAt, err := page.After.Time()
+		if err != nil {
+			return nil, nil, err
+		}
+		q += ` AND (created_at, id) < ($` + strconv.Itoa(ind) + `, $` + strconv.Itoa(ind+1) + `)`
+		values = append(values, createdAt, page.After.ID)
+		ind += 2
 	}
 
-	q += ` ORDER BY created_at desc`
+	q += ` ORDER BY created_at desc, id desc LIMIT $` + strconv.Itoa(ind)
+	values = append(values, page.FetchLimit())
 	rows, err := cr.dbConn.Query(q, values...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rows.Close()
 
+	var last, next *pagination.Cursor
 	for rows.Next() {
 		club := &models.Club{}
-		err = rows.Scan(&club.ID, &club.Name, &club.Description, pq.Array(&club.Tags), &club.EventsCount, &club.ParticipantsCount, &club.AvatarUrl, &club.ParticipantsCount, &club.SubscribersCount)
+		var createdAt time.Time
+		err = rows.Scan(&club.ID, &club.Name, &club.Description, pq.Array(&club.Tags), &club.EventsCount, &club.ParticipantsCount, &club.AvatarUrl, &club.ParticipantsCount, &club.SubscribersCount, &createdAt)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if page.Full(len(clubs)) {
+			next = last
+			break
 		}
 		clubs = append(clubs, club)
+		last = &pagination.Cursor{Key: pagination.TimeKey(createdAt), ID: club.ID}
 	}
-	return clubs, nil
+	return clubs, next, rows.Err()
 }
 
+// UpdateClub saves the name, description, avatar and tags of the club. The usage counts
+// of the tags the club gains or loses change in the same transaction.
 func (cr *ClubsRepository) UpdateClub(club *models.Club) (*models.Club, error) {
-	err := cr.dbConn.QueryRow(
-		`UPDATE clubs SET name = $1, description = $2, avatar = $3
-				WHERE id = $4
-				RETURNING id, name, description, events_count, participants_count, avatar`,
-		club.Name, club.Description, club.AvatarUrl, club.ID).Scan(&club.ID, &club.Name, &club.Description, &club.EventsCount, &club.ParticipantsCount, &club.AvatarUrl)
+	tx, err := cr.dbConn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var oldTags []string
+	err = tx.QueryRow(`SELECT tags FROM clubs WHERE id = $1 FOR UPDATE`, club.ID).Scan(pq.Array(&oldTags))
+	if err == sql.ErrNoRows {
+		return nil, clubs.ErrClubNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(
+		`UPDATE clubs SET name = $1, description = $2, avatar = $3, tags = $4
+				WHERE id = $5
+				RETURNING id, name, description, tags, events_count, participants_count, avatar, updated_at`,
+		club.Name, club.Description, club.AvatarUrl, pq.Array(club.Tags), club.ID).Scan(&club.ID, &club.Name, &club.Description, pq.Array(&club.Tags), &club.EventsCount, &club.ParticipantsCount, &club.AvatarUrl, &club.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(`UPDATE tags SET usage_count = usage_count - 1 WHERE name = any($1)`, pq.Array(missingTags(oldTags, club.Tags)))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(`UPDATE tags SET usage_count = usage_count + 1 WHERE name = any($1)`, pq.Array(missingTags(club.Tags, oldTags)))
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
 	return club, nil
 }
 
+// missingTags returns the tags of from that aren't in to, each once.
+func missingTags(from []string, to []string) []string {
+	in := make(map[string]bool, len(to))
+	for _, tag := range to {
+		in[tag] = true
+	}
+
+	var missing []string
+	for _, tag := range from {
+		if !in[tag] {
+			missing = append(missing, tag)
+			in[tag] = true
+		}
+	}
+	return missing
+}
+
 func (cr *ClubsRepository) GetTags() ([]models.Tag, error) {
 	var tags []models.Tag
 	rows, err := cr.dbConn.Query(`SELECT id, name from tags ORDER BY usage_count desc`)
@@ -173,134 +261,126 @@ func (cr *ClubsRepository) GetTags() ([]models.Tag, error) {
 	return tags, nil
 }
 
-func (cr *ClubsRepository) GetClubsUserByStatus(club_id int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.UserCard, error) {
+func (cr *ClubsRepository) GetClubsUserByStatus(club_id int64, status string, page pagination.Page) ([]*models.UserCard, *pagination.Cursor, error) {
 	var users []*models.UserCard
 	ind := 3
 	var values []interface{}
 	values = append(values, status, club_id)
-	q := `SELECT u.vk_id, u.name, u.surname, u.avatar from users_clubs as uc INNER JOIN users as u on u.vk_id = uc.user_id WHERE uc.status = $1 and uc.club_id=$2`
+	q := `SELECT u.vk_id, u.name, u.surname, u.avatar from users_clubs as uc INNER JOIN users as u on u.vk_id = uc.user_id WHERE ` + statusCondition(status) + ` and uc.club_id=$2`
 
-	if idGt != nil {
-		q += ` AND u.vk_id > $` + strconv.Itoa(ind)
-		values = append(values, idGt)
-		ind++
-	}
-
-	if idLte != nil {
-		q += ` AND u.vk_id <= $` + strconv.Itoa(ind)
-		values = append(values, idLte)
-		ind++
+	if page.After != nil {
+		q += ` AND (u.surname, u.vk_id) < ($` + strconv.Itoa(ind) + `, $` + strconv.Itoa(ind+1) + `)`
+		values = append(values, page.After.Key, page.After.ID)
+		ind += 2
 	}
 
-	if limit != nil {
-		q += ` LIMIT $` + strconv.Itoa(ind)
-		values = append(values, limit)
-	}
-
-	q += ` ORDER BY u.surname desc`
+	q += ` ORDER BY u.surname desc, u.vk_id desc LIMIT $` + strconv.Itoa(ind)
+	values = append(values, page.FetchLimit())
 	rows, err := cr.dbConn.Query(q, values...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rows.Close()
 
+	var last, next *pagination.Cursor
 	for rows.Next() {
 		user := &models.UserCard{}
 		err = rows.Scan(&user.VKID, &user.Name, &user.Surname, &user.AvatarUrl)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if page.Full(len(users)) {
+			next = last
+			break
 		}
 		users = append(users, user)
+		last = &pagination.Cursor{Key: user.Surname, ID: user.VKID}
 	}
-	return users, nil
+	return users, next, rows.Err()
 }
 
-func (cr *ClubsRepository) GetClubsCars(club_id int64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.CarCard, error) {
+func (cr *ClubsRepository) GetClubsCars(club_id int64, page pagination.Page) ([]*models.CarCard, *pagination.Cursor, error) {
 	var cars []*models.CarCard
 	ind := 2
 	var values []interface{}
 	values = append(values, club_id)
-	q := `SELECT c.id, c.owner_id, c.brand, c.model,c.date,c.description, c.avatar, c.body, c.engine, c.horse_power, c.name from cars as c JOIN users_clubs as uc on c.owner_id = uc.user_id WHERE (uc.status = 'participant' or uc.status = 'admin') and uc.club_id=$1`
+	q := `SELECT c.id, c.owner_id, c.brand, c.model,c.date,c.description, c.avatar, c.body, c.engine, c.horse_power, coalesce(c.name, '') from cars as c JOIN users_clubs as uc on c.owner_id = uc.user_id WHERE (uc.status = 'participant' or uc.status = 'moderator' or uc.status = 'admin') and uc.club_id=$1 and c.hidden = false`
 
-	if idGt != nil {
-		q += ` AND c.id > $` + strconv.Itoa(ind)
-		values = append(values, idGt)
-		ind++
+	if page.After != nil {
+		q += ` AND (coalesce(c.name, ''), c.id) < ($` + strconv.Itoa(ind) + `, $` + strconv.Itoa(ind+1) + `)`
+		values = append(values, page.After.Key, page.After.ID)
+		ind += 2
 	}
 
-	if idLte != nil {
-		q += ` AND c.id <= $` + strconv.Itoa(ind)
-		values = append(values, idLte)
-		ind++
-	}
-
-	if limit != nil {
-		q += ` LIMIT $` + strconv.Itoa(ind)
-		values = append(values, limit)
-	}
-
-	q += ` ORDER BY c.name desc`
+	q += ` ORDER BY coalesce(c.name, '') desc, c.id desc LIMIT $` + strconv.Itoa(ind)
+	values = append(values, page.FetchLimit())
 	rows, err := cr.dbConn.Query(q, values...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rows.Close()
 
+	var last, next *pagination.Cursor
 	for rows.Next() {
 		car := &models.CarCard{}
 		err = rows.Scan(&car.ID, &car.Owner.VKID, &car.Brand, &car.Model, &car.Date, &car.Description, &car.AvatarUrl, &car.Body, &car.Engine, &car.HorsePower, &car.Name)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if page.Full(len(cars)) {
+			next = last
+			break
 		}
 		cars = append(cars, car)
+		last = &pagination.Cursor{Key: car.Name, ID: car.ID}
 	}
-	return cars, nil
+	return cars, next, rows.Err()
 }
 
-func (cr *ClubsRepository) GetClubsEvents(club_id int64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.EventCard, error) {
+func (cr *ClubsRepository) GetClubsEvents(club_id int64, page pagination.Page) ([]*models.EventCard, *pagination.Cursor, error) {
 	var events []*models.EventCard
 	ind := 2
 	var values []interface{}
 	values = append(values, club_id)
-	q := `SELECT e.id, e.name, e.event_date, e.latitude, e.longitude, e.avatar, e.participants_count, e.spectators_count from events as e WHERE e.club_id=$1`
-
-	if idGt != nil {
-		q += ` AND e.id > $` + strconv.Itoa(ind)
-		values = append(values, idGt)
-		ind++
-	}
+	q := `SELECT e.id, e.name, e.event_date, e.latitude, e.longitude, e.avatar, e.participants_count, e.spectators_count from events as e WHERE e.club_id=$1 and e.hidden = false`
 
-	if idLte != nil {
-		q += ` AND e.id <= $` + strconv.Itoa(ind)
-		values = append(values, idLte)
-		ind++
-	}
-
-	if limit != nil {
-		q += ` LIMIT $` + strconv.Itoa(ind)
-		values = append(values, limit)
+	if page.After != nil {
+		eventDate, err := page.After.Time()
+		if err != nil {
+			return nil, nil, err
+		}
+		q += ` AND (e.event_date, e.id) < ($` + strconv.Itoa(ind) + `, $` + strconv.Itoa(ind+1) + `)`
+		values = append(values, eventDate, page.After.ID)
+		ind += 2
 	}
 
-	q += ` ORDER BY e.event_date desc`
+	q += ` ORDER BY e.event_date desc, e.id desc LIMIT $` + strconv.Itoa(ind)
+	values = append(values, page.FetchLimit())
 	rows, err := cr.dbConn.Query(q, values...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rows.Close()
 
+	var last, next *pagination.Cursor
 	for rows.Next() {
 		event := &models.EventCard{}
 		err = rows.Scan(&event.ID, &event.Name, &event.EventDate,
 			&event.Latitude, &event.Longitude, &event.AvatarUrl, &event.ParticipantsCount, &event.SpectatorsCount)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if page.Full(len(events)) {
+			next = last
+			break
 		}
 		events = append(events, event)
+		last = &pagination.Cursor{Key: pagination.TimeKey(event.EventDate), ID: event.ID}
 	}
-	return events, nil
+	return events, next, rows.Err()
 }
 
 func (cr *ClubsRepository) SetUserStatusByClubID(clubID int64, userID int64, status string) error {
@@ -342,6 +422,32 @@ func (cr *ClubsRepository) GetUserStatusInClub(clubID int64, userID int64) (*mod
 	return userClub, nil
 }
 
+// DecideParticipantRequest moves a user from participant_request to status, participant or subscriber,
+// and counts them in the matching counter. Only a pending request is moved, so a repeated decision
+// doesn't count the user twice and can't touch a member; both get ErrNoParticipantRequest.
+func (cr *ClubsRepository) DecideParticipantRequest(clubID int64, userID int64, status string) error {
+	counter := "subscribers_count"
+	if status == "participant" {
+		counter = "participants_count"
+	}
+
+	res, err := cr.dbConn.Exec(
+		`WITH decided AS (
+			UPDATE users_clubs SET status = $3 WHERE club_id = $1 and user_id = $2 and status = 'participant_request' RETURNING club_id)
+		UPDATE clubs SET `+counter+` = `+counter+` + 1 WHERE id IN (SELECT club_id FROM decided)`, clubID, userID, status)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return clubs.ErrNoParticipantRequest
+	}
+	return nil
+}
+
 func (cr *ClubsRepository) GetClubChatID(clubID int64, userID int64) (int64, error) {
 	var chatID int64
 	err := cr.dbConn.QueryRow(`SELECT c.chat_id FROM clubs as c inner join users_clubs as uc on c.id = uc.club_id WHERE c.id = $1 and uc.user_id = $2`, clubID, userID).Scan(&chatID)
@@ -365,6 +471,9 @@ func (cr *ClubsRepository) SetClubChatID(clubID int64, chatID int64) error {
 func (cr *ClubsRepository) DeleteUserFromClub(clubID int64, userID int64) error {
 	var status string
 	err := cr.dbConn.QueryRow(`DELETE FROM users_clubs WHERE club_id = $1 and user_id = $2 RETURNING status`, clubID, userID).Scan(&status)
+	if err == sql.ErrNoRows {
+		return clubs.ErrNotClubMember
+	}
 	if err != nil {
 		return err
 	}
@@ -372,7 +481,7 @@ func (cr *ClubsRepository) DeleteUserFromClub(clubID int64, userID int64) error
 	switch status {
 	case "subscriber":
 		query = `UPDATE clubs SET subscribers_count = subscribers_count - 1 WHERE id = $1`
-	case "participant":
+	case "participant", "moderator":
 		query = `UPDATE clubs SET participants_count = participants_count - 1 WHERE id = $1`
 	case "participant_request":
 		return nil
@@ -400,3 +509,93 @@ func (cr *ClubsRepository) ComplainByID(complaint models.Complaint) error {
 	return nil
 }
 
+
+// UpdateUserStatusInClub changes the status of an existing member without touching the counters,
+// so it is only meant for moves inside one counter group such as participant <-> moderator.
+func (cr *ClubsRepository) UpdateUserStatusInClub(clubID int64, userID int64, status string) error {
+	_, err := cr.dbConn.Exec(`UPDATE users_clubs SET status = $3 WHERE club_id = $1 and user_id = $2`, clubID, userID, status)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// clubComplaintsScope matches complaints about the club itself, its events and posts in its events.
+const clubComplaintsScope = `((c.target_type = 'club' and c.target_id = $1)
+		or (c.target_type = 'event' and c.target_id in (SELECT id FROM events WHERE club_id = $1))
+		or (c.target_type = 'post' and c.target_id in (SELECT p.id FROM events_posts as p INNER JOIN events as e on e.id = p.event_id WHERE e.club_id = $1)))`
+
+func (cr *ClubsRepository) GetClubComplaints(clubID int64, page pagination.Page) ([]*models.ComplaintCard, *pagination.Cursor, error) {
+	var complaints []*models.ComplaintCard
+	ind := 2
+	var values []interface{}
+	values = append(values, clubID)
+	q := `SELECT c.id, c.target_type, c.target_id, c.user_id, coalesce(c.text, '') from complaints as c WHERE c.status = 'open' and ` + clubComplaintsScope
+
+	if page.After != nil {
+		q += ` AND c.id > $` + strconv.Itoa(ind)
+		values = append(values, page.After.ID)
+		ind++
+	}
+
+	q += ` ORDER BY c.id LIMIT $` + strconv.Itoa(ind)
+	values = append(values, page.FetchLimit())
+	rows, err := cr.dbConn.Query(q, values...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer rows.Close()
+
+	var last, next *pagination.Cursor
+	for rows.Next() {
+		complaint := &models.ComplaintCard{}
+		err = rows.Scan(&complaint.ID, &complaint.TargetType, &complaint.TargetID, &complaint.UserID, &complaint.Text)
+		if err != nil {
+			return nil, nil, err
+		}
+		if page.Full(len(complaints)) {
+			next = last
+			break
+		}
+		complaints = append(complaints, complaint)
+		last = &pagination.Cursor{ID: complaint.ID}
+	}
+	return complaints, next, rows.Err()
+}
+
+func (cr *ClubsRepository) DismissClubComplaint(clubID int64, complaintID int64, handledBy int64) error {
+	tx, err := cr.dbConn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var id int64
+	err = tx.QueryRow(
+		`UPDATE complaints as c SET status = 'dismissed', resolved_by = $3, resolved_at = now(), resolution = 'dismiss'
+				WHERE c.id = $2 and c.status = 'open' and `+clubComplaintsScope+` RETURNING c.id`, clubID, complaintID, handledBy).Scan(&id)
+	if err == sql.ErrNoRows {
+		return clubs.ErrOpenComplaintNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`INSERT INTO complaints_history (complaint_id, handled_by, action) VALUES ($1, $2, 'dismiss')`, complaintID, handledBy)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// statusCondition matches users_clubs rows with the status in $1. Moderators are
+// participants with extra rights and are counted in participants_count, so they
+// are listed among the participants as well.
+func statusCondition(status string) string {
+	if status == "participant" {
+		return `uc.status IN ($1, 'moderator')`
+	}
+	return `uc.status = $1`
+}
@@ -1,19 +1,33 @@
 package events
 
 import (
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"mime/multipart"
 )
 
+var (
+	ErrClubNotFound          = apperrors.NotFound("club not found")
+	ErrNotClubMember         = apperrors.NotFound("user is not a member of the club")
+	ErrOpenComplaintNotFound = apperrors.NotFound("open complaint not found")
+	ErrNoParticipantRequest  = apperrors.Conflict("user has no participant request")
+)
+
 type IClubsUsecase interface {
 	CreateClub(event *models.Club) error
 	GetClubByID(id uint64, userID uint64) (*models.Club, error)
-	GetClubs(idGt *uint64, idLte *uint64, limit *uint64, query *string) ([]*models.Club, error)
+	// GetClubsByIDs is GetClubByID for many clubs at once. It skips the ids of missing clubs
+	// and returns the clubs in no particular order.
+	GetClubsByIDs(ids []uint64, userID uint64) ([]*models.Club, error)
+	GetClubs(page pagination.Page, query *string) ([]*models.Club, *pagination.Cursor, error)
+	// UpdateClub changes the fields set in update and leaves the others as they are.
+	UpdateClub(clubID int64, update *models.UpdateClubRequest) (*models.Club, error)
 	UpdateAvatar(eventID int64, fileHeader *multipart.FileHeader) (*models.Club, error)
 	GetTags() ([]models.Tag, error)
-	GetClubsUserByStatus(club_id int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.UserCard, error)
-	GetClubsCars(club_id int64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.CarCard, error)
-	GetClubsEvents(club_id int64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.EventCard, error)
+	GetClubsUserByStatus(club_id int64, status string, page pagination.Page) ([]*models.UserCard, *pagination.Cursor, error)
+	GetClubsCars(club_id int64, page pagination.Page) ([]*models.CarCard, *pagination.Cursor, error)
+	GetClubsEvents(club_id int64, page pagination.Page) ([]*models.EventCard, *pagination.Cursor, error)
 	SetUserStatusByClubID(clubID int64, userID int64, status string) error
 	ApproveRejectUserParticipateInClub(clubID int64, userID int64, decision string) error
 	GetUserStatusInClub(clubID int64, userID int64) (*models.ClubUser, error)
@@ -22,4 +36,8 @@ type IClubsUsecase interface {
 	DeleteUserFromClub(clubID int64, userID int64) error
 	DeleteClubByID(clubID int64) error
 	ComplainByID(complaint models.Complaint) error
+	PromoteModerator(clubID int64, userID int64) error
+	DemoteModerator(clubID int64, userID int64) error
+	GetClubComplaints(clubID int64, page pagination.Page) ([]*models.ComplaintCard, *pagination.Cursor, error)
+	DismissClubComplaint(clubID int64, complaintID int64, handledBy int64) error
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/dantedoyl/car-life-api/internal/app/clients/filesystem"
 	clubs "github.com/dantedoyl/car-life-api/internal/app/clubs"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"mime/multipart"
 )
 
@@ -25,8 +26,31 @@ func (cu *ClubsUsecase) GetClubByID(id uint64, userID uint64) (*models.Club, err
 	return cu.clubsRepo.GetClubByID(int64(id), userID)
 }
 
-func (cu *ClubsUsecase) GetClubs(idGt *uint64, idLte *uint64, limit *uint64, query *string) ([]*models.Club, error) {
-	return cu.clubsRepo.GetClubs(idGt, idLte, limit, query)
+func (cu *ClubsUsecase) GetClubsByIDs(ids []uint64, userID uint64) ([]*models.Club, error) {
+	return cu.clubsRepo.GetClubsByIDs(ids, userID)
+}
+
+func (cu *ClubsUsecase) GetClubs(page pagination.Page, query *string) ([]*models.Club, *pagination.Cursor, error) {
+	return cu.clubsRepo.GetClubs(page, query)
+}
+
+func (cu *ClubsUsecase) UpdateClub(clubID int64, update *models.UpdateClubRequest) (*models.Club, error) {
+	club, err := cu.clubsRepo.GetClubByID(clubID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Name != nil {
+		club.Name = *update.Name
+	}
+	if update.Description != nil {
+		club.Description = *update.Description
+	}
+	if update.Tags != nil {
+		club.Tags = update.Tags
+	}
+
+	return cu.clubsRepo.UpdateClub(club)
 }
 
 func (cu *ClubsUsecase) UpdateAvatar(clubID int64, fileHeader *multipart.FileHeader) (*models.Club, error) {
@@ -63,16 +87,16 @@ func (cu *ClubsUsecase) GetTags() ([]models.Tag, error) {
 	return cu.clubsRepo.GetTags()
 }
 
-func (cu *ClubsUsecase) GetClubsUserByStatus(club_id int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.UserCard, error) {
-	return cu.clubsRepo.GetClubsUserByStatus(club_id, status, idGt, idLte, limit)
+func (cu *ClubsUsecase) GetClubsUserByStatus(club_id int64, status string, page pagination.Page) ([]*models.UserCard, *pagination.Cursor, error) {
+	return cu.clubsRepo.GetClubsUserByStatus(club_id, status, page)
 }
 
-func (cu *ClubsUsecase) GetClubsCars(club_id int64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.CarCard, error) {
-	return cu.clubsRepo.GetClubsCars(club_id, idGt, idLte, limit)
+func (cu *ClubsUsecase) GetClubsCars(club_id int64, page pagination.Page) ([]*models.CarCard, *pagination.Cursor, error) {
+	return cu.clubsRepo.GetClubsCars(club_id, page)
 }
 
-func (cu *ClubsUsecase) GetClubsEvents(club_id int64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.EventCard, error) {
-	return cu.clubsRepo.GetClubsEvents(club_id, idGt, idLte, limit)
+func (cu *ClubsUsecase) GetClubsEvents(club_id int64, page pagination.Page) ([]*models.EventCard, *pagination.Cursor, error) {
+	return cu.clubsRepo.GetClubsEvents(club_id, page)
 }
 
 func (cu *ClubsUsecase) SetUserStatusByClubID(clubID int64, userID int64, status string) error {
@@ -81,9 +105,9 @@ func (cu *ClubsUsecase) SetUserStatusByClubID(clubID int64, userID int64, status
 
 func (cu *ClubsUsecase) ApproveRejectUserParticipateInClub(clubID int64, userID int64, decision string) error {
 	if decision == "approve" {
-		return cu.clubsRepo.SetUserStatusByClubID(clubID, userID, "participant")
+		return cu.clubsRepo.DecideParticipantRequest(clubID, userID, "participant")
 	}
-	return cu.clubsRepo.SetUserStatusByClubID(clubID, userID, "subscriber")
+	return cu.clubsRepo.DecideParticipantRequest(clubID, userID, "subscriber")
 }
 
 func (cu *ClubsUsecase) GetUserStatusInClub(clubID int64, userID int64) (*models.ClubUser, error) {
@@ -109,3 +133,19 @@ func (cu *ClubsUsecase) DeleteClubByID(clubID int64) error {
 func (cu *ClubsUsecase) ComplainByID(complaint models.Complaint) error {
 	return cu.clubsRepo.ComplainByID(complaint)
 }
+
+func (cu *ClubsUsecase) PromoteModerator(clubID int64, userID int64) error {
+	return cu.clubsRepo.UpdateUserStatusInClub(clubID, userID, "moderator")
+}
+
+func (cu *ClubsUsecase) DemoteModerator(clubID int64, userID int64) error {
+	return cu.clubsRepo.UpdateUserStatusInClub(clubID, userID, "participant")
+}
+
+func (cu *ClubsUsecase) GetClubComplaints(clubID int64, page pagination.Page) ([]*models.ComplaintCard, *pagination.Cursor, error) {
+	return cu.clubsRepo.GetClubComplaints(clubID, page)
+}
+
+func (cu *ClubsUsecase) DismissClubComplaint(clubID int64, complaintID int64, handledBy int64) error {
+	return cu.clubsRepo.DismissClubComplaint(clubID, complaintID, handledBy)
+}
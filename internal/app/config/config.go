@@ -0,0 +1,370 @@
+// Package config loads the application settings from a YAML file and the environment.
+// Environment variables take precedence over the file, and the file over the defaults.
+package config
+
+import (
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultPath = "config/config.yaml"
+
+type Config struct {
+	Server    ServerConfig    `yaml:"server"`
+	Postgres  PostgresConfig  `yaml:"postgres"`
+	Tarantool TarantoolConfig `yaml:"tarantool"`
+	Sessions  SessionsConfig  `yaml:"sessions"`
+	VK        VKConfig        `yaml:"vk"`
+	CORS      CORSConfig      `yaml:"cors"`
+
+	Idempotency IdempotencyConfig `yaml:"idempotency"`
+	RateLimit   RateLimitConfig   `yaml:"rate_limit"`
+}
+
+type ServerConfig struct {
+	Addr string `yaml:"addr"`
+	// GRPCAddr is where the gRPC server listens for the other backend services, empty turns it off.
+	// The server is plaintext and takes anonymous calls, so it is off by default and should only
+	// listen on a private interface such as 127.0.0.1:9090.
+	GRPCAddr string `yaml:"grpc_addr"`
+	// MetricsAddr is where /metrics is served for Prometheus, apart from the public API.
+	// Empty turns it off.
+	MetricsAddr string `yaml:"metrics_addr"`
+	// TrustedProxies are the IPs or CIDRs of the reverse proxies in front of the server.
+	// X-Forwarded-For is only believed when the peer is one of them, otherwise the peer
+	// address is the client's.
+	TrustedProxies []string      `yaml:"trusted_proxies"`
+	ReadTimeout    time.Duration `yaml:"read_timeout"`
+	WriteTimeout   time.Duration `yaml:"write_timeout"`
+	// ShutdownTimeout is how long requests in flight may run after SIGTERM.
+	ShutdownTimeout    time.Duration `yaml:"shutdown_timeout"`
+	HealthCheckTimeout time.Duration `yaml:"health_check_timeout"`
+}
+
+type PostgresConfig struct {
+	DSN             string        `yaml:"dsn"`
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+}
+
+type TarantoolConfig struct {
+	Addr     string        `yaml:"addr"`
+	User     string        `yaml:"user"`
+	Password string        `yaml:"password"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
+type SessionsConfig struct {
+	// Store is one of tarantool, postgres or memory.
+	Store         string        `yaml:"store"`
+	AccessTTL     time.Duration `yaml:"access_ttl"`
+	RefreshTTL    time.Duration `yaml:"refresh_ttl"`
+	SlidingWindow time.Duration `yaml:"sliding_window"`
+}
+
+type CORSConfig struct {
+	// AllowedOrigins are scheme://host[:port] origins; a * stands for any subdomains
+	// and a lone * allows every origin, but without credentials.
+	AllowedOrigins []string      `yaml:"allowed_origins"`
+	AllowedHeaders []string      `yaml:"allowed_headers"`
+	ExposedHeaders []string      `yaml:"exposed_headers"`
+	MaxAge         time.Duration `yaml:"max_age"`
+}
+
+type IdempotencyConfig struct {
+	// TTL is how long the response to a request with an Idempotency-Key is replayed.
+	TTL time.Duration `yaml:"ttl"`
+}
+
+type RateLimitConfig struct {
+	// Store is tarantool or memory. The tarantool store falls back to memory while Tarantool is down.
+	Store         string    `yaml:"store"`
+	Auth          RateLimit `yaml:"auth"`
+	Complaints    RateLimit `yaml:"complaints"`
+	Posts         RateLimit `yaml:"posts"`
+	Uploads       RateLimit `yaml:"uploads"`
+	Participation RateLimit `yaml:"participation"`
+}
+
+// RateLimit allows Requests requests per Per on average and up to Burst at once.
+// Zero Requests turns the limit off.
+type RateLimit struct {
+	Requests int           `yaml:"requests"`
+	Per      time.Duration `yaml:"per"`
+	Burst    int           `yaml:"burst"`
+}
+
+type VKConfig struct {
+	ServiceKey      string        `yaml:"service_key"`
+	GroupKey        string        `yaml:"group_key"`
+	AppSecret       string        `yaml:"app_secret"`
+	AppURL          string        `yaml:"app_url"`
+	LaunchParamsTTL time.Duration `yaml:"launch_params_ttl"`
+	Timeout         time.Duration `yaml:"timeout"`
+}
+
+func defaults() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Addr:               ":8080",
+			GRPCAddr:           "",
+			MetricsAddr:        "127.0.0.1:9100",
+			ReadTimeout:        60 * time.Second,
+			WriteTimeout:       60 * time.Second,
+			ShutdownTimeout:    15 * time.Second,
+			HealthCheckTimeout: 2 * time.Second,
+		},
+		Postgres: PostgresConfig{
+			MaxOpenConns:    20,
+			MaxIdleConns:    10,
+			ConnMaxLifetime: time.Hour,
+		},
+		Tarantool: TarantoolConfig{
+			Addr:    "127.0.0.1:3301",
+			Timeout: 3 * time.Second,
+		},
+		Sessions: SessionsConfig{
+			Store:         "tarantool",
+			AccessTTL:     30 * time.Minute,
+			RefreshTTL:    30 * 24 * time.Hour,
+			SlidingWindow: 30 * time.Minute,
+		},
+		VK: VKConfig{
+			LaunchParamsTTL: 24 * time.Hour,
+			Timeout:         10 * time.Second,
+		},
+		CORS: CORSConfig{
+			// The mini app is served from vk-apps.com and framed by the VK web and mobile sites.
+			AllowedOrigins: []string{"https://vk.com", "https://m.vk.com", "https://vk.ru", "https://m.vk.ru", "https://*.vk-apps.com"},
+			AllowedHeaders: []string{"content-type", "auth", "if-none-match", "idempotency-key", "x-request-id"},
+			ExposedHeaders: []string{"X-Request-ID", "Location", "ETag", "Idempotent-Replayed", "Retry-After"},
+			MaxAge:         10 * time.Minute,
+		},
+		Idempotency: IdempotencyConfig{
+			TTL: 24 * time.Hour,
+		},
+		RateLimit: RateLimitConfig{
+			Store:         "tarantool",
+			Auth:          RateLimit{Requests: 20, Per: time.Minute, Burst: 10},
+			Complaints:    RateLimit{Requests: 10, Per: time.Hour, Burst: 5},
+			Posts:         RateLimit{Requests: 30, Per: time.Hour, Burst: 5},
+			Uploads:       RateLimit{Requests: 60, Per: time.Hour, Burst: 10},
+			Participation: RateLimit{Requests: 30, Per: time.Hour, Burst: 10},
+		},
+	}
+}
+
+// Load reads the file named by CONFIG_PATH (config/config.yaml if unset), applies
+// the environment overrides and validates the result. The default file is optional,
+// so the whole configuration can come from the environment.
+func Load() (*Config, error) {
+	cfg := defaults()
+
+	path, explicit := os.LookupEnv("CONFIG_PATH")
+	if !explicit {
+		path = defaultPath
+	}
+
+	data, err := ioutil.ReadFile(path)
+	switch {
+	case err == nil:
+		err = yaml.UnmarshalStrict(data, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("config %s: %w", path, err)
+		}
+	case os.IsNotExist(err) && !explicit:
+	default:
+		return nil, fmt.Errorf("config: %w", err)
+	}
+
+	err = cfg.applyEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) applyEnv() error {
+	stringVars := map[string]*string{
+		"SERVER_ADDR":         &c.Server.Addr,
+		"SERVER_GRPC_ADDR":    &c.Server.GRPCAddr,
+		"SERVER_METRICS_ADDR": &c.Server.MetricsAddr,
+		"POSTGRES_DSN":        &c.Postgres.DSN,
+		"TARANTOOL_ADDR":      &c.Tarantool.Addr,
+		"TARANTOOL_USER":      &c.Tarantool.User,
+		"TARANTOOL_PASSWORD":  &c.Tarantool.Password,
+		"SESSION_STORE":       &c.Sessions.Store,
+		"RATE_LIMIT_STORE":    &c.RateLimit.Store,
+		"VK_SERVICE_KEY":      &c.VK.ServiceKey,
+		"VK_GROUP_KEY":        &c.VK.GroupKey,
+		"VK_APP_SECRET":       &c.VK.AppSecret,
+		"VK_APP_URL":          &c.VK.AppURL,
+	}
+	for key, field := range stringVars {
+		if value, ok := os.LookupEnv(key); ok {
+			*field = value
+		}
+	}
+
+	durationVars := map[string]*time.Duration{
+		"SERVER_READ_TIMEOUT":        &c.Server.ReadTimeout,
+		"SERVER_WRITE_TIMEOUT":       &c.Server.WriteTimeout,
+		"SERVER_SHUTDOWN_TIMEOUT":    &c.Server.ShutdownTimeout,
+		"SERVER_HEALTH_TIMEOUT":      &c.Server.HealthCheckTimeout,
+		"TARANTOOL_TIMEOUT":          &c.Tarantool.Timeout,
+		"POSTGRES_CONN_MAX_LIFETIME": &c.Postgres.ConnMaxLifetime,
+		"SESSION_ACCESS_TTL":         &c.Sessions.AccessTTL,
+		"SESSION_REFRESH_TTL":        &c.Sessions.RefreshTTL,
+		"SESSION_SLIDING_WINDOW":     &c.Sessions.SlidingWindow,
+		"VK_LAUNCH_PARAMS_TTL":       &c.VK.LaunchParamsTTL,
+		"VK_TIMEOUT":                 &c.VK.Timeout,
+		"CORS_MAX_AGE":               &c.CORS.MaxAge,
+		"IDEMPOTENCY_TTL":            &c.Idempotency.TTL,
+	}
+	for key, field := range durationVars {
+		value, ok := os.LookupEnv(key)
+		if !ok {
+			continue
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("config: %s: %w", key, err)
+		}
+		*field = duration
+	}
+
+	listVars := map[string]*[]string{
+		"SERVER_TRUSTED_PROXIES": &c.Server.TrustedProxies,
+		"CORS_ALLOWED_ORIGINS":   &c.CORS.AllowedOrigins,
+		"CORS_ALLOWED_HEADERS":   &c.CORS.AllowedHeaders,
+		"CORS_EXPOSED_HEADERS":   &c.CORS.ExposedHeaders,
+	}
+	for key, field := range listVars {
+		value, ok := os.LookupEnv(key)
+		if !ok {
+			continue
+		}
+		*field = nil
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*field = append(*field, item)
+			}
+		}
+	}
+
+	intVars := map[string]*int{
+		"POSTGRES_MAX_OPEN_CONNS": &c.Postgres.MaxOpenConns,
+		"POSTGRES_MAX_IDLE_CONNS": &c.Postgres.MaxIdleConns,
+	}
+	for key, field := range intVars {
+		value, ok := os.LookupEnv(key)
+		if !ok {
+			continue
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("config: %s: %w", key, err)
+		}
+		*field = number
+	}
+
+	return nil
+}
+
+// UsesTarantool reports whether the sessions or the rate limits are kept in Tarantool.
+func (c *Config) UsesTarantool() bool {
+	return c.Sessions.Store == "tarantool" || c.RateLimit.Store == "tarantool"
+}
+
+// Validate reports all missing or invalid settings at once.
+func (c *Config) Validate() error {
+	var problems []string
+	check := func(ok bool, problem string) {
+		if !ok {
+			problems = append(problems, problem)
+		}
+	}
+
+	check(c.Server.Addr != "", "server.addr is required")
+	check(c.Server.MetricsAddr != c.Server.Addr, "server.metrics_addr must differ from server.addr")
+	for _, proxy := range c.Server.TrustedProxies {
+		_, _, cidrErr := net.ParseCIDR(proxy)
+		check(cidrErr == nil || net.ParseIP(proxy) != nil, "server.trusted_proxies: "+proxy+" must be an IP or a CIDR")
+	}
+	check(c.Server.ReadTimeout > 0, "server.read_timeout must be positive")
+	check(c.Server.WriteTimeout > 0, "server.write_timeout must be positive")
+	check(c.Server.ShutdownTimeout > 0, "server.shutdown_timeout must be positive")
+	check(c.Server.HealthCheckTimeout > 0, "server.health_check_timeout must be positive")
+
+	check(c.Postgres.DSN != "", "postgres.dsn is required")
+	check(c.Postgres.MaxOpenConns >= 0, "postgres.max_open_conns must not be negative")
+	check(c.Postgres.MaxIdleConns >= 0, "postgres.max_idle_conns must not be negative")
+
+	switch c.Sessions.Store {
+	case "tarantool", "postgres", "memory":
+	default:
+		problems = append(problems, "sessions.store must be one of tarantool, postgres or memory")
+	}
+	if c.UsesTarantool() {
+		check(c.Tarantool.Addr != "", "tarantool.addr is required")
+		check(c.Tarantool.User != "", "tarantool.user is required")
+		check(c.Tarantool.Timeout > 0, "tarantool.timeout must be positive")
+	}
+	check(c.Sessions.AccessTTL > 0, "sessions.access_ttl must be positive")
+	check(c.Sessions.RefreshTTL >= c.Sessions.AccessTTL, "sessions.refresh_ttl must not be shorter than sessions.access_ttl")
+	check(c.Sessions.SlidingWindow > 0, "sessions.sliding_window must be positive")
+
+	check(c.VK.ServiceKey != "", "vk.service_key is required")
+	check(c.VK.GroupKey != "", "vk.group_key is required")
+	check(c.VK.AppSecret != "", "vk.app_secret is required")
+	check(c.VK.AppURL != "", "vk.app_url is required")
+	check(c.VK.LaunchParamsTTL > 0, "vk.launch_params_ttl must be positive")
+	check(c.VK.Timeout > 0, "vk.timeout must be positive")
+
+	check(len(c.CORS.AllowedOrigins) != 0, "cors.allowed_origins is required")
+	for _, origin := range c.CORS.AllowedOrigins {
+		check(origin == "*" || (strings.Contains(origin, "://") && strings.Count(origin, "*") <= 1),
+			"cors.allowed_origins: "+origin+" must be * or scheme://host with at most one *")
+	}
+	check(c.CORS.MaxAge >= 0, "cors.max_age must not be negative")
+
+	check(c.Idempotency.TTL > 0, "idempotency.ttl must be positive")
+
+	check(c.RateLimit.Store == "tarantool" || c.RateLimit.Store == "memory", "rate_limit.store must be tarantool or memory")
+	limits := []struct {
+		name  string
+		limit RateLimit
+	}{
+		{"auth", c.RateLimit.Auth},
+		{"complaints", c.RateLimit.Complaints},
+		{"posts", c.RateLimit.Posts},
+		{"uploads", c.RateLimit.Uploads},
+		{"participation", c.RateLimit.Participation},
+	}
+	for _, l := range limits {
+		if l.limit.Requests == 0 {
+			continue
+		}
+		check(l.limit.Requests > 0, "rate_limit."+l.name+".requests must not be negative")
+		check(l.limit.Per > 0, "rate_limit."+l.name+".per must be positive")
+		check(l.limit.Burst > 0, "rate_limit."+l.name+".burst must be positive")
+	}
+
+	if len(problems) != 0 {
+		return fmt.Errorf("config: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package contract
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/clients/vk"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Case is one request to a route of the API and the status it has to be answered with.
+type Case struct {
+	Method string
+	// Route is the path template the route is registered with in Configure, regexps included.
+	Route string
+	// Path is the request path relative to the API base path, query string included.
+	Path string
+	// Auth is the session sent in the auth header, none if empty.
+	Auth string
+	// Body is sent as JSON unless it is nil.
+	Body interface{}
+	// Upload sends a multipart form with an image in the file-upload field instead of Body.
+	Upload bool
+	// Status is the status the case has to be answered with, zero accepts any documented one.
+	Status int
+}
+
+func (c Case) String() string {
+	return c.Method + " " + c.Path
+}
+
+func id(value uint64) string {
+	return strconv.FormatUint(value, 10)
+}
+
+// launchParams signs the launch query string VK would start the mini app for userID with.
+func launchParams(verifier *vk.LaunchParamsVerifier, userID uint64, now time.Time) string {
+	params := url.Values{
+		"vk_user_id":  {id(userID)},
+		"vk_app_id":   {"1"},
+		"vk_platform": {"mobile_web"},
+		"vk_ts":       {strconv.FormatInt(now.Unix(), 10)},
+	}
+	params.Set("sign", verifier.Sign(params))
+	return params.Encode()
+}
+
+// Cases covers every route of API v1 with at least one successful request,
+// and the common ways to fail with a few more.
+func Cases(verifier *vk.LaunchParamsVerifier, now time.Time) []Case {
+	complaint := models.ComplaintReq{Text: "Spam"}
+
+	return []Case{
+		{Method: http.MethodPost, Route: "/signup", Path: "/signup", Status: http.StatusOK, Body: models.SignUpRequest{
+			LaunchParams: launchParams(verifier, guestID+1, now),
+			Name:         "Ivan",
+			Surname:      "Ivanov",
+			AvatarUrl:    "https://example.com/img/users/avatar.jpg",
+			Garage: []models.CarRequest{{
+				Brand: "Lada", Model: "2107", Date: fixtureTime, Description: "Daily driver",
+				Body: "sedan", Engine: "1.6", HorsePower: "75", Name: "Semerka",
+			}},
+			Tags:        []string{"drift"},
+			Description: "Drives a lot",
+		}},
+		{Method: http.MethodPost, Route: "/signup", Path: "/signup", Status: http.StatusBadRequest, Body: models.SignUpRequest{
+			LaunchParams: launchParams(verifier, guestID+1, now),
+		}},
+		{Method: http.MethodPost, Route: "/signup", Path: "/signup", Status: http.StatusUnauthorized, Body: models.SignUpRequest{
+			LaunchParams: "vk_user_id=1&sign=forged", Name: "Ivan", Surname: "Ivanov",
+		}},
+		{Method: http.MethodPost, Route: "/login", Path: "/login", Status: http.StatusOK, Body: models.LoginRequest{
+			LaunchParams: launchParams(verifier, ownerID, now),
+		}},
+		{Method: http.MethodPost, Route: "/login", Path: "/login", Status: http.StatusUnauthorized, Body: models.LoginRequest{
+			LaunchParams: launchParams(verifier, unknownID, now),
+		}},
+		{Method: http.MethodPost, Route: "/session/refresh", Path: "/session/refresh", Status: http.StatusOK,
+			Body: models.RefreshRequest{RefreshToken: refreshToken}},
+		{Method: http.MethodPost, Route: "/session/refresh", Path: "/session/refresh", Status: http.StatusUnauthorized,
+			Body: models.RefreshRequest{RefreshToken: "stolen"}},
+		{Method: http.MethodPost, Route: "/logout", Path: "/logout", Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/me", Path: "/me", Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/me", Path: "/me", Status: http.StatusUnauthorized},
+		{Method: http.MethodPut, Route: "/me/update", Path: "/me/update", Auth: ownerSession, Status: http.StatusOK,
+			Body: models.UpdateRequest{Tags: []string{"drag"}, Description: "Drives even more"}},
+		{Method: http.MethodGet, Route: "/me/sessions", Path: "/me/sessions", Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodDelete, Route: "/me/sessions", Path: "/me/sessions", Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodDelete, Route: "/me/sessions/{id:[0-9a-f-]+}", Path: "/me/sessions/" + sessionID, Auth: ownerSession,
+			Status: http.StatusOK},
+		{Method: http.MethodDelete, Route: "/me/sessions/{id:[0-9a-f-]+}", Path: "/me/sessions/0000", Auth: ownerSession,
+			Status: http.StatusNotFound},
+		{Method: http.MethodGet, Route: "/user/{id:[0-9]+}", Path: "/user/" + id(ownerID), Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/user/{id:[0-9]+}", Path: "/user/" + id(unknownID), Auth: ownerSession, Status: http.StatusNotFound},
+		{Method: http.MethodGet, Route: "/user/{id:[0-9]+}/garage", Path: "/user/" + id(ownerID) + "/garage", Auth: ownerSession,
+			Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/user/{id:[0-9]+}/complain", Path: "/user/" + id(ownerID) + "/complain", Auth: guestSession,
+			Body: complaint, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/user/{id:[0-9]+}/events/{type:admin|participant|spectator}",
+			Path: "/user/" + id(ownerID) + "/events/admin", Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/user/{id:[0-9]+}/clubs/{type:admin|participant|subscriber}",
+			Path: "/user/" + id(ownerID) + "/clubs/participant", Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/user/own_clubs", Path: "/user/own_clubs", Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/new_car", Path: "/new_car", Auth: ownerSession, Status: http.StatusOK, Body: models.CarRequest{
+			Brand: "Volga", Model: "3110", Date: fixtureTime, Description: "Weekend car",
+			Body: "sedan", Engine: "2.4", HorsePower: "150", Name: "Volga",
+		}},
+		{Method: http.MethodGet, Route: "/garage/{id:[0-9]+}", Path: "/garage/" + id(carID), Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/garage/{id:[0-9]+}", Path: "/garage/" + id(unknownID), Auth: ownerSession,
+			Status: http.StatusNotFound},
+		{Method: http.MethodPost, Route: "/garage/{id:[0-9]+}/upload", Path: "/garage/" + id(carID) + "/upload", Auth: ownerSession,
+			Upload: true, Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/garage/{id:[0-9]+}/upload", Path: "/garage/" + id(carID) + "/upload", Auth: guestSession,
+			Upload: true, Status: http.StatusForbidden},
+		{Method: http.MethodPost, Route: "/garage/{id:[0-9]+}/delete", Path: "/garage/" + id(carID) + "/delete", Auth: ownerSession,
+			Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/garage/{id:[0-9]+}/complain", Path: "/garage/" + id(carID) + "/complain", Auth: guestSession,
+			Body: complaint, Status: http.StatusOK},
+
+		{Method: http.MethodPost, Route: "/club/create", Path: "/club/create", Auth: ownerSession, Status: http.StatusOK,
+			Body: models.CreateClubRequest{Name: "Drag club", Description: "Quarter mile", Tags: []string{"drag"}}},
+		{Method: http.MethodPost, Route: "/club/create", Path: "/club/create", Status: http.StatusUnauthorized,
+			Body: models.CreateClubRequest{Name: "Drag club"}},
+		{Method: http.MethodPost, Route: "/club/create", Path: "/club/create", Auth: ownerSession, Status: http.StatusBadRequest,
+			Body: models.CreateClubRequest{}},
+		{Method: http.MethodGet, Route: "/clubs", Path: "/clubs?Query=drift&Limit=1", Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/clubs/tags", Path: "/clubs/tags", Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/clubs/{id:[0-9]+}", Path: "/clubs/" + id(clubID), Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/clubs/{id:[0-9]+}", Path: "/clubs/" + id(unknownID), Status: http.StatusNotFound},
+		{Method: http.MethodPut, Route: "/clubs/{id:[0-9]+}", Path: "/clubs/" + id(clubID), Auth: ownerSession, Status: http.StatusOK,
+			Body: map[string]interface{}{"name": "Drift club 2", "tags": []string{"drift", "jdm"}}},
+		{Method: http.MethodPut, Route: "/clubs/{id:[0-9]+}", Path: "/clubs/" + id(clubID), Auth: guestSession, Status: http.StatusForbidden,
+			Body: map[string]interface{}{"name": "Drift club 2"}},
+		{Method: http.MethodPut, Route: "/clubs/{id:[0-9]+}", Path: "/clubs/" + id(clubID), Auth: ownerSession, Status: http.StatusBadRequest,
+			Body: map[string]interface{}{}},
+		{Method: http.MethodPost, Route: "/clubs/{id:[0-9]+}/upload", Path: "/clubs/" + id(clubID) + "/upload", Auth: ownerSession,
+			Upload: true, Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/clubs/{id:[0-9]+}/upload", Path: "/clubs/" + id(clubID) + "/upload", Auth: guestSession,
+			Upload: true, Status: http.StatusForbidden},
+		{Method: http.MethodPost, Route: "/clubs/{id:[0-9]+}/{type:participate|subscribe}", Path: "/clubs/" + id(clubID) + "/participate",
+			Auth: guestSession, Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/clubs/{cid:[0-9]+}/participate/{uid:[0-9]+}/{type:approve|reject}",
+			Path: "/clubs/" + id(clubID) + "/participate/" + id(requesterID) + "/approve", Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/clubs/{cid:[0-9]+}/participate/{uid:[0-9]+}/{type:approve|reject}",
+			Path: "/clubs/" + id(clubID) + "/participate/" + id(memberID) + "/reject", Auth: ownerSession, Status: http.StatusBadRequest},
+		{Method: http.MethodPost, Route: "/clubs/{cid:[0-9]+}/participate/{uid:[0-9]+}/{type:approve|reject}",
+			Path: "/clubs/" + id(clubID) + "/participate/" + id(guestID) + "/approve", Auth: ownerSession, Status: http.StatusNotFound},
+		{Method: http.MethodGet, Route: "/clubs/{id:[0-9]+}/{type:participant_request}", Path: "/clubs/" + id(clubID) + "/participant_request",
+			Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/clubs/{id:[0-9]+}/{type:participant|subscriber}", Path: "/clubs/" + id(clubID) + "/participant",
+			Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/clubs/{id:[0-9]+}/leave", Path: "/clubs/" + id(clubID) + "/leave", Auth: ownerSession,
+			Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/clubs/{id:[0-9]+}/cars", Path: "/clubs/" + id(clubID) + "/cars", Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/clubs/{id:[0-9]+}/events", Path: "/clubs/" + id(clubID) + "/events", Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/clubs/{id:[0-9]+}/chat_link", Path: "/clubs/" + id(clubID) + "/chat_link", Auth: ownerSession,
+			Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/clubs/{id:[0-9]+}/delete", Path: "/clubs/" + id(clubID) + "/delete", Auth: ownerSession,
+			Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/clubs/{id:[0-9]+}/delete", Path: "/clubs/" + id(clubID) + "/delete", Auth: guestSession,
+			Status: http.StatusForbidden},
+		{Method: http.MethodPost, Route: "/clubs/{id:[0-9]+}/complain", Path: "/clubs/" + id(clubID) + "/complain", Auth: guestSession,
+			Body: complaint, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/clubs/{id:[0-9]+}/moderators", Path: "/clubs/" + id(clubID) + "/moderators",
+			Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/clubs/{id:[0-9]+}/moderators/{uid:[0-9]+}", Path: "/clubs/" + id(clubID) + "/moderators/" + id(memberID),
+			Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodDelete, Route: "/clubs/{id:[0-9]+}/moderators/{uid:[0-9]+}", Path: "/clubs/" + id(clubID) + "/moderators/" + id(moderatorID),
+			Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/clubs/{id:[0-9]+}/complaints", Path: "/clubs/" + id(clubID) + "/complaints", Auth: ownerSession,
+			Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/clubs/{id:[0-9]+}/complaints/{complaint_id:[0-9]+}/dismiss",
+			Path: "/clubs/" + id(clubID) + "/complaints/" + id(complaintID) + "/dismiss", Auth: ownerSession, Status: http.StatusOK},
+
+		{Method: http.MethodPost, Route: "/event/create", Path: "/event/create", Auth: ownerSession, Status: http.StatusOK,
+			Body: models.CreateEventRequest{
+				Name: "Night drag", Description: "Quarter mile at night", EventDate: now.Add(24 * time.Hour),
+				Latitude: 55.75, Longitude: 37.61, ClubID: clubID,
+			}},
+		{Method: http.MethodPost, Route: "/event/create", Path: "/event/create", Auth: guestSession, Status: http.StatusForbidden,
+			Body: models.CreateEventRequest{
+				Name: "Night drag", EventDate: now.Add(24 * time.Hour), Latitude: 55.75, Longitude: 37.61, ClubID: clubID,
+			}},
+		{Method: http.MethodGet, Route: "/events", Path: "/events?Query=drift", Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/events/{id:[0-9]+}", Path: "/events/" + id(eventID), Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/events/{id:[0-9]+}", Path: "/events/" + id(unknownID), Status: http.StatusNotFound},
+		{Method: http.MethodPost, Route: "/events/{id:[0-9]+}/upload", Path: "/events/" + id(eventID) + "/upload", Auth: ownerSession,
+			Upload: true, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/events/{id:[0-9]+}/{type:participant_request}", Path: "/events/" + id(eventID) + "/participant_request",
+			Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/events/{id:[0-9]+}/{type:participant|spectator}", Path: "/events/" + id(eventID) + "/spectator",
+			Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/events/{id:[0-9]+}/{type:participate|spectate}", Path: "/events/" + id(eventID) + "/participate",
+			Auth: guestSession, Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/events/{eid:[0-9]+}/participate/{uid:[0-9]+}/{type:approve|reject}",
+			Path: "/events/" + id(eventID) + "/participate/" + id(guestID) + "/reject", Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/events/{id:[0-9]+}/chat_link", Path: "/events/" + id(eventID) + "/chat_link", Auth: ownerSession,
+			Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/events/{id:[0-9]+}/leave", Path: "/events/" + id(eventID) + "/leave", Auth: guestSession,
+			Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/events/{id:[0-9]+}/delete", Path: "/events/" + id(eventID) + "/delete", Auth: ownerSession,
+			Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/events/{id:[0-9]+}/complain", Path: "/events/" + id(eventID) + "/complain", Auth: guestSession,
+			Body: complaint, Status: http.StatusOK},
+
+		{Method: http.MethodPost, Route: "/event_posts/{event_id:[0-9]+}/create", Path: "/event_posts/" + id(eventID) + "/create",
+			Auth: ownerSession, Body: models.CreatePostRequest{Text: "See you there"}, Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/event_posts/{event_id:[0-9]+}/create", Path: "/event_posts/" + id(eventID) + "/create",
+			Auth: ownerSession, Body: models.CreatePostRequest{}, Status: http.StatusBadRequest},
+		{Method: http.MethodGet, Route: "/event_posts/{event_id:[0-9]+}", Path: "/event_posts/" + id(eventID), Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/events_posts/{post_id:[0-9]+}/upload", Path: "/events_posts/" + id(postID) + "/upload",
+			Auth: ownerSession, Upload: true, Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/event_posts/{post_id:[0-9]+}/delete", Path: "/event_posts/" + id(postID) + "/delete",
+			Auth: ownerSession, Status: http.StatusOK},
+		{Method: http.MethodPost, Route: "/event_posts/{post_id:[0-9]+}/complain", Path: "/event_posts/" + id(postID) + "/complain",
+			Auth: guestSession, Body: complaint, Status: http.StatusOK},
+
+		{Method: http.MethodPost, Route: "/mini_event/create", Path: "/mini_event/create", Auth: ownerSession, Status: http.StatusOK,
+			Body: models.CreateMiniEventRequest{
+				TypeID: 1, EndedAt: now.Add(time.Hour), Description: "Stuck in the snow", Latitude: 55.75, Longitude: 37.61,
+			}},
+		{Method: http.MethodGet, Route: "/mini_events", Path: "/mini_events", Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/mini_events/{id:[0-9]+}", Path: "/mini_events/" + id(miniEventID), Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/mini_events/{id:[0-9]+}", Path: "/mini_events/" + id(unknownID), Status: http.StatusNotFound},
+
+		{Method: http.MethodGet, Route: "/admin/complaints", Path: "/admin/complaints?TargetType=club", Auth: ownerSession,
+			Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/admin/complaints", Path: "/admin/complaints", Auth: guestSession, Status: http.StatusForbidden},
+		{Method: http.MethodGet, Route: "/admin/complaints/{id:[0-9]+}", Path: "/admin/complaints/" + id(complaintID), Auth: ownerSession,
+			Status: http.StatusOK},
+		{Method: http.MethodGet, Route: "/admin/complaints/{id:[0-9]+}", Path: "/admin/complaints/" + id(unknownID), Auth: ownerSession,
+			Status: http.StatusNotFound},
+		{Method: http.MethodPost, Route: "/admin/complaints/{id:[0-9]+}/resolve", Path: "/admin/complaints/" + id(complaintID) + "/resolve",
+			Auth: ownerSession, Body: models.ResolveComplaintRequest{Action: "dismiss", Comment: "Not spam"}, Status: http.StatusOK},
+	}
+}
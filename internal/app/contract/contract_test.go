@@ -0,0 +1,95 @@
+package contract
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+const specFile = "../../../docs/swagger.json"
+
+func newTestChecker(t *testing.T) *Checker {
+	t.Helper()
+	doc, err := os.ReadFile(specFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec, err := ParseSpec(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewChecker(spec, "contract")
+}
+
+// TestContractRoutes fails when a route has no case or isn't documented, and when the spec
+// documents an operation no route serves.
+func TestContractRoutes(t *testing.T) {
+	c := newTestChecker(t)
+	routes, err := c.routes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	covered := map[string]bool{}
+	for _, cs := range Cases(c.verifier, time.Now()) {
+		covered[cs.Method+" "+cs.Route] = true
+	}
+
+	served := map[string]bool{}
+	for _, route := range routes {
+		path := specPath(route.template)
+		served[route.method+" "+path] = true
+
+		if !covered[route.method+" "+route.template] {
+			t.Errorf("no case for route %s %s", route.method, route.template)
+		}
+		if _, ok := c.spec.Operation(route.method, path); !ok {
+			t.Errorf("route %s %s is not in the spec", route.method, path)
+		}
+	}
+
+	var unrouted []string
+	for path, ops := range c.spec.Paths {
+		for method := range ops {
+			method = strings.ToUpper(method)
+			if !served[method+" "+path] {
+				unrouted = append(unrouted, method+" "+path)
+			}
+		}
+	}
+	sort.Strings(unrouted)
+	for _, op := range unrouted {
+		t.Errorf("spec operation %s has no route", op)
+	}
+}
+
+// TestContractCases fails when an answer has a status the spec doesn't document for the route,
+// or a body with a field the schema doesn't list or without a required one. Every case that takes
+// a session is run once more without one, so that the way the route refuses anonymous requests
+// has to be documented too.
+func TestContractCases(t *testing.T) {
+	c := newTestChecker(t)
+	for _, cs := range Cases(c.verifier, time.Now()) {
+		c.runCase(t, cs.String(), cs)
+		if cs.Auth != "" {
+			anonymous := cs
+			anonymous.Auth = ""
+			anonymous.Status = 0
+			c.runCase(t, cs.String()+" without a session", anonymous)
+		}
+	}
+}
+
+func (c *Checker) runCase(t *testing.T, name string, cs Case) {
+	t.Run(name, func(t *testing.T) {
+		problems, err := c.run(cs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, problem := range problems {
+			t.Error(problem)
+		}
+	})
+}
@@ -0,0 +1,6 @@
+// Package contract checks that API v1 answers the way docs/swagger.json says it does.
+// Its tests call every route registered by the Configure methods through the real handlers and middlewares,
+// with in-memory fakes in place of the usecases, and compare every answer with the documented
+// status codes and response schemas. Whatever differs is drift of either the code or the annotations,
+// so run go test after changing a handler or its annotations and regenerating the docs.
+package contract
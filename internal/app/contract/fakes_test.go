@@ -0,0 +1,785 @@
+package contract
+
+import (
+	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/admin"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+	clubs "github.com/dantedoyl/car-life-api/internal/app/clubs"
+	"github.com/dantedoyl/car-life-api/internal/app/events"
+	"github.com/dantedoyl/car-life-api/internal/app/events_posts"
+	"github.com/dantedoyl/car-life-api/internal/app/mini_events"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+	"github.com/dantedoyl/car-life-api/internal/app/sessions"
+	"github.com/dantedoyl/car-life-api/internal/app/users"
+	"mime/multipart"
+	"time"
+)
+
+// The fixtures every fake serves. The owner owns the car, the club, the event, the post and the mini event
+// and is platform staff, so every action is allowed to them and to nobody else.
+// The member and the moderator are what their names say in the club, the requester has asked to join it
+// and the guest is nothing anywhere.
+const (
+	ownerID     = 1001
+	memberID    = 1002
+	moderatorID = 1003
+	guestID     = 1004
+	requesterID = 1005
+
+	carID       = 2001
+	clubID      = 3001
+	eventID     = 4001
+	postID      = 5001
+	miniEventID = 6001
+	complaintID = 7001
+	tagID       = 8001
+	chatID      = 9001
+
+	// unknownID is not the id of anything, for the 404 cases.
+	unknownID = 999999
+
+	ownerSession = "contract-owner-session"
+	guestSession = "contract-guest-session"
+	refreshToken = "contract-refresh-token"
+	sessionID    = "6f1c2b9e-3d4a-4c1e-9b7a-2f3e4d5c6b7a"
+)
+
+// fixtureTime is fixed so that the responses don't change between runs.
+var fixtureTime = time.Date(2022, time.May, 14, 12, 0, 0, 0, time.UTC)
+
+var clubStatuses = map[uint64]string{
+	ownerID:     "admin",
+	memberID:    "participant",
+	moderatorID: "moderator",
+	requesterID: "participant_request",
+}
+
+func userFixture(id uint64) *models.User {
+	return &models.User{
+		VKID:        id,
+		Name:        "Ivan",
+		Surname:     "Ivanov",
+		AvatarUrl:   "https://example.com/img/users/avatar.jpg",
+		Tags:        []string{"drift"},
+		Description: "Drives a lot",
+		UpdatedAt:   fixtureTime,
+	}
+}
+
+func userCardFixture(id uint64) models.UserCard {
+	user := userFixture(id)
+	return models.UserCard{
+		VKID:      user.VKID,
+		Name:      user.Name,
+		Surname:   user.Surname,
+		AvatarUrl: user.AvatarUrl,
+	}
+}
+
+func carFixture() *models.CarCard {
+	return &models.CarCard{
+		ID:          carID,
+		AvatarUrl:   "https://example.com/img/cars/avatar.jpg",
+		Brand:       "Lada",
+		Model:       "2107",
+		Date:        fixtureTime,
+		Description: "Daily driver",
+		Owner:       userCardFixture(ownerID),
+		Body:        "sedan",
+		Engine:      "1.6",
+		HorsePower:  "75",
+		Name:        "Semerka",
+	}
+}
+
+func clubFixture(userID uint64) *models.Club {
+	return &models.Club{
+		ID:                clubID,
+		Name:              "Drift club",
+		Description:       "Sideways every weekend",
+		AvatarUrl:         "https://example.com/img/clubs/avatar.jpg",
+		Tags:              []string{"drift"},
+		EventsCount:       1,
+		ParticipantsCount: 3,
+		SubscribersCount:  1,
+		Owner:             userCardFixture(ownerID),
+		UserStatus:        clubStatuses[userID],
+		UpdatedAt:         fixtureTime,
+	}
+}
+
+func clubCardFixture() *models.ClubCard {
+	club := clubFixture(0)
+	return &models.ClubCard{
+		ID:                club.ID,
+		Name:              club.Name,
+		AvatarUrl:         club.AvatarUrl,
+		Tags:              club.Tags,
+		ParticipantsCount: club.ParticipantsCount,
+		SubscribersCount:  club.SubscribersCount,
+	}
+}
+
+func eventFixture(userID uint64) *models.Event {
+	event := &models.Event{
+		ID:                eventID,
+		Name:              "Night drift",
+		Club:              *clubFixture(userID),
+		Creator:           userCardFixture(ownerID),
+		Description:       "Bring tires",
+		EventDate:         fixtureTime.Add(7 * 24 * time.Hour),
+		Latitude:          55.75,
+		Longitude:         37.61,
+		AvatarUrl:         "https://example.com/img/events/avatar.jpg",
+		ParticipantsCount: 2,
+		SpectatorsCount:   5,
+		UpdatedAt:         fixtureTime,
+	}
+	if userID == ownerID {
+		event.UserStatus = "admin"
+	}
+	return event
+}
+
+func eventCardFixture() *models.EventCard {
+	event := eventFixture(0)
+	return &models.EventCard{
+		ID:                event.ID,
+		Name:              event.Name,
+		EventDate:         event.EventDate,
+		AvatarUrl:         event.AvatarUrl,
+		Latitude:          event.Latitude,
+		Longitude:         event.Longitude,
+		ParticipantsCount: event.ParticipantsCount,
+		SpectatorsCount:   event.SpectatorsCount,
+	}
+}
+
+func postFixture() *models.EventPost {
+	return &models.EventPost{
+		ID:          postID,
+		Text:        "See you there",
+		User:        userCardFixture(ownerID),
+		EventID:     eventID,
+		CreatedAt:   fixtureTime,
+		Attachments: []string{"https://example.com/img/events-posts/photo.jpg"},
+	}
+}
+
+func miniEventFixture() *models.MiniEvent {
+	return &models.MiniEvent{
+		ID: miniEventID,
+		Type: models.MiniEventType{
+			ID:                1,
+			PublicName:        "Stuck",
+			PublicDescription: "Needs a tow",
+		},
+		User:        userCardFixture(ownerID),
+		Description: "Stuck in the snow",
+		CreatedAt:   fixtureTime,
+		EndedAt:     fixtureTime.Add(time.Hour),
+		Latitude:    55.75,
+		Longitude:   37.61,
+	}
+}
+
+func complaintCardFixture() *models.ComplaintCard {
+	return &models.ComplaintCard{
+		ID:         complaintID,
+		TargetType: "club",
+		TargetID:   clubID,
+		UserID:     guestID,
+		Text:       "Spam",
+	}
+}
+
+func adminComplaintFixture() *models.AdminComplaint {
+	resolvedBy := userCardFixture(ownerID)
+	resolvedAt := fixtureTime.Add(time.Hour)
+	return &models.AdminComplaint{
+		ID:         complaintID,
+		TargetType: "club",
+		TargetID:   clubID,
+		Author:     userCardFixture(guestID),
+		Text:       "Spam",
+		Status:     "resolved",
+		CreatedAt:  fixtureTime,
+		ResolvedBy: &resolvedBy,
+		ResolvedAt: &resolvedAt,
+		Resolution: "dismiss",
+	}
+}
+
+func sessionFixture(value string) (*models.Session, bool) {
+	userID := uint64(ownerID)
+	if value == guestSession {
+		userID = guestID
+	} else if value != ownerSession {
+		return nil, false
+	}
+
+	return &models.Session{
+		ID:         sessionID,
+		Value:      value,
+		FamilyID:   "contract-family",
+		UserID:     userID,
+		ExpiresAt:  fixtureTime.Add(24 * time.Hour),
+		CreatedAt:  fixtureTime,
+		LastSeenAt: fixtureTime,
+		UserAgent:  "contract",
+		IP:         "127.0.0.1",
+	}, true
+}
+
+func refreshTokenFixture(userID uint64) *models.RefreshToken {
+	return &models.RefreshToken{
+		Value:        refreshToken,
+		FamilyID:     "contract-family",
+		UserID:       userID,
+		SessionValue: ownerSession,
+		ExpiresAt:    fixtureTime.Add(30 * 24 * time.Hour),
+		CreatedAt:    fixtureTime,
+	}
+}
+
+func knownUser(id uint64) bool {
+	return id == ownerID || id == memberID || id == moderatorID || id == guestID
+}
+
+// nextPage makes every list say there is more, so that next_cursor is filled in too.
+var nextPage = &pagination.Cursor{ID: 1}
+
+type fakeUsers struct{}
+
+func (fakeUsers) StartSession(userID uint64, userAgent string, ip string) (*models.Session, *models.RefreshToken, error) {
+	session, _ := sessionFixture(ownerSession)
+	session.UserID = userID
+	return session, refreshTokenFixture(userID), nil
+}
+
+func (fakeUsers) RefreshSession(token string, userAgent string, ip string) (*models.Session, *models.RefreshToken, error) {
+	if token != refreshToken {
+		return nil, nil, sessions.ErrRefreshTokenNotFound
+	}
+	session, _ := sessionFixture(ownerSession)
+	return session, refreshTokenFixture(ownerID), nil
+}
+
+func (fakeUsers) GetSession(sessValue string) (*models.Session, error) {
+	session, ok := sessionFixture(sessValue)
+	if !ok {
+		return nil, sessions.ErrSessionNotFound
+	}
+	return session, nil
+}
+
+func (fakeUsers) DeleteSession(sessionValue string) error {
+	return nil
+}
+
+func (fu fakeUsers) CheckSession(sessValue string) (*models.Session, error) {
+	return fu.GetSession(sessValue)
+}
+
+func (fakeUsers) GetUserSessions(userID uint64, currentSessValue string) ([]*models.SessionInfo, error) {
+	session, _ := sessionFixture(currentSessValue)
+	return []*models.SessionInfo{{
+		ID:         session.ID,
+		CreatedAt:  session.CreatedAt,
+		LastSeenAt: session.LastSeenAt,
+		ExpiresAt:  session.ExpiresAt,
+		UserAgent:  session.UserAgent,
+		IP:         session.IP,
+		Current:    true,
+	}}, nil
+}
+
+func (fakeUsers) DeleteUserSessionByID(userID uint64, id string) error {
+	if id != sessionID {
+		return sessions.ErrSessionNotFound
+	}
+	return nil
+}
+
+func (fakeUsers) DeleteAllUserSessions(userID uint64) error {
+	return nil
+}
+
+func (fakeUsers) Create(user *models.User, car *models.CarCard) (*models.User, error) {
+	created := *user
+	created.UpdatedAt = fixtureTime
+	if car != nil {
+		created.CarID = carID
+	}
+	return &created, nil
+}
+
+func (fakeUsers) GetByID(vkID uint64) (*models.User, error) {
+	if !knownUser(vkID) {
+		return nil, users.ErrUserNotFound
+	}
+	return userFixture(vkID), nil
+}
+
+func (fakeUsers) GetByIDs(vkIDs []uint64) ([]*models.User, error) {
+	var found []*models.User
+	for _, id := range vkIDs {
+		if knownUser(id) {
+			found = append(found, userFixture(id))
+		}
+	}
+	return found, nil
+}
+
+func (fakeUsers) GetClubsByUserStatus(userID int64, status string, page pagination.Page) ([]*models.ClubCard, *pagination.Cursor, error) {
+	if !knownUser(uint64(userID)) {
+		return nil, nil, users.ErrUserNotFound
+	}
+	return []*models.ClubCard{clubCardFixture()}, nextPage, nil
+}
+
+func (fakeUsers) UpdateAvatar(id uint64, fileHeader *multipart.FileHeader) (*models.User, error) {
+	if id != carID {
+		return nil, users.ErrCarNotFound
+	}
+	return userFixture(ownerID), nil
+}
+
+func (fakeUsers) AddNewUserCar(car *models.CarCard) (*models.CarCard, error) {
+	added := *car
+	added.ID = carID + 1
+	added.Owner = userCardFixture(car.Owner.VKID)
+	return &added, nil
+}
+
+func (fakeUsers) SelectCarByUserID(userID int64, page pagination.Page) ([]*models.CarCard, *pagination.Cursor, error) {
+	if !knownUser(uint64(userID)) {
+		return nil, nil, users.ErrUserNotFound
+	}
+	return []*models.CarCard{carFixture()}, nextPage, nil
+}
+
+func (fakeUsers) SelectCarByID(id int64, userID uint64) (*models.CarCard, error) {
+	if id != carID {
+		return nil, users.ErrCarNotFound
+	}
+	return carFixture(), nil
+}
+
+func (fakeUsers) GetEventsByUserStatus(userID int64, status string, page pagination.Page) ([]*models.EventCard, *pagination.Cursor, error) {
+	if !knownUser(uint64(userID)) {
+		return nil, nil, users.ErrUserNotFound
+	}
+	return []*models.EventCard{eventCardFixture()}, nextPage, nil
+}
+
+func (fakeUsers) UpdateUserInfo(user *models.User) (*models.User, error) {
+	updated := userFixture(user.VKID)
+	updated.Tags = user.Tags
+	updated.Description = user.Description
+	return updated, nil
+}
+
+func (fakeUsers) DeleteCarByID(id int64) error {
+	if id != carID {
+		return users.ErrCarNotFound
+	}
+	return nil
+}
+
+func (fakeUsers) ComplainByID(target string, complaint models.Complaint) error {
+	if target == "car" && complaint.TargetID != carID {
+		return users.ErrCarNotFound
+	}
+	if target == "user" && !knownUser(uint64(complaint.TargetID)) {
+		return users.ErrUserNotFound
+	}
+	return nil
+}
+
+type fakeClubs struct{}
+
+func clubExists(id int64) error {
+	if id != clubID {
+		return clubs.ErrClubNotFound
+	}
+	return nil
+}
+
+func (fakeClubs) CreateClub(club *models.Club) error {
+	club.ID = clubID + 1
+	club.UpdatedAt = fixtureTime
+	return nil
+}
+
+func (fakeClubs) GetClubByID(id uint64, userID uint64) (*models.Club, error) {
+	if err := clubExists(int64(id)); err != nil {
+		return nil, err
+	}
+	return clubFixture(userID), nil
+}
+
+func (fakeClubs) GetClubsByIDs(ids []uint64, userID uint64) ([]*models.Club, error) {
+	var found []*models.Club
+	for _, id := range ids {
+		if id == clubID {
+			found = append(found, clubFixture(userID))
+		}
+	}
+	return found, nil
+}
+
+func (fakeClubs) GetClubs(page pagination.Page, query *string) ([]*models.Club, *pagination.Cursor, error) {
+	return []*models.Club{clubFixture(0)}, nextPage, nil
+}
+
+func (fakeClubs) UpdateClub(id int64, update *models.UpdateClubRequest) (*models.Club, error) {
+	if err := clubExists(id); err != nil {
+		return nil, err
+	}
+	club := clubFixture(ownerID)
+	if update.Name != nil {
+		club.Name = *update.Name
+	}
+	if update.Description != nil {
+		club.Description = *update.Description
+	}
+	if update.Tags != nil {
+		club.Tags = update.Tags
+	}
+	return club, nil
+}
+
+func (fakeClubs) UpdateAvatar(id int64, fileHeader *multipart.FileHeader) (*models.Club, error) {
+	if err := clubExists(id); err != nil {
+		return nil, err
+	}
+	return clubFixture(ownerID), nil
+}
+
+func (fakeClubs) GetTags() ([]models.Tag, error) {
+	return []models.Tag{{ID: tagID, Name: "drift"}}, nil
+}
+
+func (fakeClubs) GetClubsUserByStatus(id int64, status string, page pagination.Page) ([]*models.UserCard, *pagination.Cursor, error) {
+	if err := clubExists(id); err != nil {
+		return nil, nil, err
+	}
+	card := userCardFixture(memberID)
+	return []*models.UserCard{&card}, nextPage, nil
+}
+
+func (fakeClubs) GetClubsCars(id int64, page pagination.Page) ([]*models.CarCard, *pagination.Cursor, error) {
+	if err := clubExists(id); err != nil {
+		return nil, nil, err
+	}
+	return []*models.CarCard{carFixture()}, nextPage, nil
+}
+
+func (fakeClubs) GetClubsEvents(id int64, page pagination.Page) ([]*models.EventCard, *pagination.Cursor, error) {
+	if err := clubExists(id); err != nil {
+		return nil, nil, err
+	}
+	return []*models.EventCard{eventCardFixture()}, nextPage, nil
+}
+
+func (fakeClubs) SetUserStatusByClubID(id int64, userID int64, status string) error {
+	return clubExists(id)
+}
+
+func (fakeClubs) ApproveRejectUserParticipateInClub(id int64, userID int64, decision string) error {
+	return clubExists(id)
+}
+
+func (fakeClubs) GetUserStatusInClub(id int64, userID int64) (*models.ClubUser, error) {
+	if err := clubExists(id); err != nil {
+		return nil, err
+	}
+	status, ok := clubStatuses[uint64(userID)]
+	if !ok {
+		return nil, nil
+	}
+	return &models.ClubUser{UserID: userID, ClubID: id, Status: status}, nil
+}
+
+func (fakeClubs) SetClubChatID(id int64, chat int64) error {
+	return nil
+}
+
+func (fakeClubs) GetClubChatID(id int64, userID int64) (int64, error) {
+	if err := clubExists(id); err != nil {
+		return 0, err
+	}
+	if _, ok := clubStatuses[uint64(userID)]; !ok {
+		return 0, clubs.ErrNotClubMember
+	}
+	return chatID, nil
+}
+
+func (fakeClubs) DeleteUserFromClub(id int64, userID int64) error {
+	return clubExists(id)
+}
+
+func (fakeClubs) DeleteClubByID(id int64) error {
+	return clubExists(id)
+}
+
+func (fakeClubs) ComplainByID(complaint models.Complaint) error {
+	return clubExists(complaint.TargetID)
+}
+
+func (fakeClubs) PromoteModerator(id int64, userID int64) error {
+	return clubExists(id)
+}
+
+func (fakeClubs) DemoteModerator(id int64, userID int64) error {
+	return clubExists(id)
+}
+
+func (fakeClubs) GetClubComplaints(id int64, page pagination.Page) ([]*models.ComplaintCard, *pagination.Cursor, error) {
+	if err := clubExists(id); err != nil {
+		return nil, nil, err
+	}
+	return []*models.ComplaintCard{complaintCardFixture()}, nextPage, nil
+}
+
+func (fakeClubs) DismissClubComplaint(id int64, complaint int64, handledBy int64) error {
+	if err := clubExists(id); err != nil {
+		return err
+	}
+	if complaint != complaintID {
+		return clubs.ErrOpenComplaintNotFound
+	}
+	return nil
+}
+
+type fakeEvents struct{}
+
+func eventExists(id int64) error {
+	if id != eventID {
+		return events.ErrEventNotFound
+	}
+	return nil
+}
+
+func (fakeEvents) CreateEvent(event *models.Event) error {
+	event.ID = eventID + 1
+	event.UpdatedAt = fixtureTime
+	return nil
+}
+
+func (fakeEvents) GetEventByID(id uint64, userID uint64) (*models.Event, error) {
+	if err := eventExists(int64(id)); err != nil {
+		return nil, err
+	}
+	return eventFixture(userID), nil
+}
+
+func (fakeEvents) GetEventsByIDs(ids []uint64, userID uint64) ([]*models.Event, error) {
+	var found []*models.Event
+	for _, id := range ids {
+		if id == eventID {
+			found = append(found, eventFixture(userID))
+		}
+	}
+	return found, nil
+}
+
+func (fakeEvents) GetEvents(page pagination.Page, query *string, downLeftLongitude *float32, downLeftLatitude *float32,
+	upperRightLongitude *float32, upperRightLatitude *float32) ([]*models.Event, *pagination.Cursor, error) {
+	return []*models.Event{eventFixture(0)}, nextPage, nil
+}
+
+func (fakeEvents) UpdateAvatar(id int64, fileHeader *multipart.FileHeader) (*models.Event, error) {
+	if err := eventExists(id); err != nil {
+		return nil, err
+	}
+	return eventFixture(ownerID), nil
+}
+
+func (fakeEvents) GetEventsUserByStatus(id int64, status string, page pagination.Page) ([]*models.UserCard, *pagination.Cursor, error) {
+	if err := eventExists(id); err != nil {
+		return nil, nil, err
+	}
+	card := userCardFixture(memberID)
+	return []*models.UserCard{&card}, nextPage, nil
+}
+
+func (fakeEvents) SetUserStatusByEventID(id int64, userID int64, status string) error {
+	return eventExists(id)
+}
+
+func (fakeEvents) ApproveRejectUserParticipateInEvent(id int64, userID int64, decision string) error {
+	return eventExists(id)
+}
+
+func (fakeEvents) GetEventChatID(id int64, userID int64) (int64, error) {
+	if err := eventExists(id); err != nil {
+		return 0, err
+	}
+	if userID != ownerID {
+		return 0, events.ErrNotEventMember
+	}
+	return chatID, nil
+}
+
+func (fakeEvents) SetEventChatID(id int64, chat int64) error {
+	return nil
+}
+
+func (fakeEvents) DeleteUserFromEvent(id int64, userID int64) error {
+	return eventExists(id)
+}
+
+func (fakeEvents) DeleteEventByID(id int64) error {
+	return eventExists(id)
+}
+
+func (fakeEvents) ComplainByID(complaint models.Complaint) error {
+	return eventExists(complaint.TargetID)
+}
+
+type fakeEventsPosts struct{}
+
+func postExists(id uint64) error {
+	if id != postID {
+		return events_posts.ErrPostNotFound
+	}
+	return nil
+}
+
+func (fakeEventsPosts) CreateEventPost(post *models.EventPost) error {
+	if err := eventExists(int64(post.EventID)); err != nil {
+		return err
+	}
+	post.ID = postID + 1
+	post.CreatedAt = fixtureTime
+	return nil
+}
+
+func (fakeEventsPosts) GetEventsPostsByEventID(id uint64, page pagination.Page) ([]*models.EventPost, *pagination.Cursor, error) {
+	if err := eventExists(int64(id)); err != nil {
+		return nil, nil, err
+	}
+	return []*models.EventPost{postFixture()}, nextPage, nil
+}
+
+func (fakeEventsPosts) UploadAttachments(id uint64, fileHeader []*multipart.FileHeader) (*models.EventPost, error) {
+	if err := postExists(id); err != nil {
+		return nil, err
+	}
+	return postFixture(), nil
+}
+
+func (fakeEventsPosts) GetEventPostByPostID(id uint64, userID uint64) (*models.EventPost, error) {
+	if err := postExists(id); err != nil {
+		return nil, err
+	}
+	return postFixture(), nil
+}
+
+func (fakeEventsPosts) DeletePostByID(id int64) error {
+	return postExists(uint64(id))
+}
+
+func (fakeEventsPosts) ComplainByID(complaint models.Complaint) error {
+	return postExists(uint64(complaint.TargetID))
+}
+
+type fakeMiniEvents struct{}
+
+func (fakeMiniEvents) CreateMiniEvent(event *models.MiniEvent) error {
+	event.ID = miniEventID + 1
+	event.CreatedAt = fixtureTime
+	return nil
+}
+
+func (fakeMiniEvents) GetMiniEventByID(id uint64) (*models.MiniEvent, error) {
+	if id != miniEventID {
+		return nil, mini_events.ErrMiniEventNotFound
+	}
+	return miniEventFixture(), nil
+}
+
+func (fakeMiniEvents) GetMiniEvents(page pagination.Page, query *string) ([]*models.MiniEvent, *pagination.Cursor, error) {
+	return []*models.MiniEvent{miniEventFixture()}, nextPage, nil
+}
+
+type fakeAdmin struct{}
+
+func (fakeAdmin) GetComplaints(targetType *string, status *string, page pagination.Page) ([]*models.AdminComplaint, *pagination.Cursor, error) {
+	return []*models.AdminComplaint{adminComplaintFixture()}, nextPage, nil
+}
+
+func (fakeAdmin) GetComplaintDetails(id uint64, staffID uint64) (*models.ComplaintDetails, error) {
+	if id != complaintID {
+		return nil, admin.ErrComplaintNotFound
+	}
+	return &models.ComplaintDetails{
+		Complaint: adminComplaintFixture(),
+		Target:    clubFixture(0),
+		History: []*models.ComplaintHistoryEntry{{
+			ID:        1,
+			Action:    "dismiss",
+			Comment:   "Not spam",
+			HandledBy: userCardFixture(ownerID),
+			CreatedAt: fixtureTime.Add(time.Hour),
+		}},
+	}, nil
+}
+
+func (fakeAdmin) ResolveComplaint(id uint64, staffID uint64, action string, comment string) error {
+	if id != complaintID {
+		return admin.ErrComplaintNotFound
+	}
+	return nil
+}
+
+// fakeAuthorization allows everything to the owner and nothing to anybody else,
+// and doesn't know the resources other than the fixtures.
+type fakeAuthorization struct{}
+
+func (fakeAuthorization) Can(userID uint64, action authorization.Action, resourceID uint64) error {
+	rule, ok := authorization.Policy[action]
+	if !ok {
+		return authorization.ErrForbidden
+	}
+
+	known := map[authorization.ResourceType]uint64{
+		authorization.ResourceClub:  clubID,
+		authorization.ResourceEvent: eventID,
+		authorization.ResourcePost:  postID,
+		authorization.ResourceCar:   carID,
+	}
+	if id, ok := known[rule.Resource]; ok && id != resourceID {
+		return authorization.ErrResourceNotFound
+	}
+
+	if userID != ownerID {
+		return authorization.ErrForbidden
+	}
+	return nil
+}
+
+type fakeVK struct{}
+
+func (fakeVK) CreatChat(ctx context.Context, title string) (int, error) {
+	return chatID, nil
+}
+
+func (fakeVK) EditChat(ctx context.Context, id int, title string) error {
+	return nil
+}
+
+func (fakeVK) UploadChatPhoto(ctx context.Context, id int, fileHeader *multipart.FileHeader) error {
+	return nil
+}
+
+func (fakeVK) GetChatLink(ctx context.Context, id int) (string, error) {
+	return "https://vk.me/join/contract", nil
+}
+
+func (fakeVK) CreatMessage(ctx context.Context, userID int, msg string) error {
+	return nil
+}
@@ -0,0 +1,175 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	admin_delivery "github.com/dantedoyl/car-life-api/internal/app/admin/delivery/http"
+	"github.com/dantedoyl/car-life-api/internal/app/clients/vk"
+	clubs_delivery "github.com/dantedoyl/car-life-api/internal/app/clubs/delivery/http"
+	events_delivery "github.com/dantedoyl/car-life-api/internal/app/events/delivery/http"
+	events_posts_delivery "github.com/dantedoyl/car-life-api/internal/app/events_posts/delivery/http"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"github.com/dantedoyl/car-life-api/internal/app/middleware"
+	mini_events_delivery "github.com/dantedoyl/car-life-api/internal/app/mini_events/delivery/http"
+	users_delivery "github.com/dantedoyl/car-life-api/internal/app/users/delivery/http"
+	"github.com/gorilla/mux"
+	"image"
+	"image/png"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Checker serves API v1 on the fakes under the base path of the spec.
+type Checker struct {
+	spec     *Spec
+	router   *mux.Router
+	verifier *vk.LaunchParamsVerifier
+}
+
+// NewChecker builds the router the way bootstrap does, with the fakes instead of the usecases
+// and without the rate limits and the idempotency store, which pass everything through when unset.
+func NewChecker(spec *Spec, appSecret string) *Checker {
+	verifier := vk.NewLaunchParamsVerifier(appSecret, time.Hour)
+	mw := middleware.NewMiddleware(fakeUsers{}, fakeAuthorization{}, nil, 0, nil, nil, nil, logger.New(io.Discard))
+
+	router := mux.NewRouter()
+	router.Use(mw.RequestLogMiddleware)
+
+	api := router.PathPrefix(spec.BasePath).Subrouter()
+	events_delivery.NewEventsHandler(fakeEvents{}, fakeAuthorization{}, fakeVK{}, "https://vk.com/app1").Configure(api, mw)
+	clubs_delivery.NewClubsHandler(fakeClubs{}, fakeVK{}, "https://vk.com/app1").Configure(api, mw)
+	users_delivery.NewUserssHandler(fakeUsers{}, verifier).Configure(api, mw)
+	mini_events_delivery.NewMiniEventsHandler(fakeMiniEvents{}).Configure(api, mw)
+	events_posts_delivery.NewEventsPostsHandler(fakeEventsPosts{}).Configure(api, mw)
+	admin_delivery.NewAdminHandler(fakeAdmin{}).Configure(api, mw)
+
+	return &Checker{
+		spec:     spec,
+		router:   router,
+		verifier: verifier,
+	}
+}
+
+type route struct {
+	method   string
+	template string
+}
+
+// routes lists the method and the template relative to the base path of every registered route.
+// OPTIONS is skipped, the routes only accept it for the CORS preflight.
+func (c *Checker) routes() ([]route, error) {
+	var routes []route
+	err := c.router.Walk(func(r *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		template, err := r.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+		methods, err := r.GetMethods()
+		if err != nil {
+			return nil
+		}
+
+		for _, method := range methods {
+			if method == http.MethodOptions {
+				continue
+			}
+			routes = append(routes, route{method: method, template: strings.TrimPrefix(template, c.spec.BasePath)})
+		}
+		return nil
+	})
+	return routes, err
+}
+
+var routeVar = regexp.MustCompile(`\{([^:}]+):[^}]*\}`)
+
+// specPath turns a mux template into the path swag writes: /clubs/{id:[0-9]+} is /clubs/{id}.
+func specPath(template string) string {
+	return routeVar.ReplaceAllString(template, "{$1}")
+}
+
+func (c *Checker) run(cs Case) ([]string, error) {
+	req, err := c.request(cs)
+	if err != nil {
+		return nil, err
+	}
+
+	rec := httptest.NewRecorder()
+	c.router.ServeHTTP(rec, req)
+
+	var problems []string
+	if cs.Status != 0 && rec.Code != cs.Status {
+		problems = append(problems, fmt.Sprintf("answered %d, want %d: %s", rec.Code, cs.Status, strings.TrimSpace(rec.Body.String())))
+	}
+
+	op, ok := c.spec.Operation(cs.Method, specPath(cs.Route))
+	if !ok {
+		return problems, nil
+	}
+
+	resp, ok := op.Responses[strconv.Itoa(rec.Code)]
+	if !ok {
+		return append(problems, fmt.Sprintf("status %d is not documented", rec.Code)), nil
+	}
+
+	body := bytes.TrimSpace(rec.Body.Bytes())
+	switch {
+	case len(body) == 0 && resp.Schema != nil:
+		problems = append(problems, fmt.Sprintf("status %d has no body, but one is documented", rec.Code))
+	case len(body) != 0 && resp.Schema == nil:
+		problems = append(problems, fmt.Sprintf("status %d has a body, but none is documented", rec.Code))
+	case len(body) != 0:
+		var value interface{}
+		err := json.Unmarshal(body, &value)
+		if err != nil {
+			return append(problems, fmt.Sprintf("status %d body is not JSON: %v", rec.Code, err)), nil
+		}
+		problems = append(problems, c.spec.Validate(resp.Schema, value, "body")...)
+	}
+
+	return problems, nil
+}
+
+func (c *Checker) request(cs Case) (*http.Request, error) {
+	body := &bytes.Buffer{}
+	contentType := ""
+
+	switch {
+	case cs.Upload:
+		form := multipart.NewWriter(body)
+		file, err := form.CreateFormFile("file-upload", "contract.png")
+		if err != nil {
+			return nil, err
+		}
+		err = png.Encode(file, image.NewRGBA(image.Rect(0, 0, 1, 1)))
+		if err != nil {
+			return nil, err
+		}
+		err = form.Close()
+		if err != nil {
+			return nil, err
+		}
+		contentType = form.FormDataContentType()
+	case cs.Body != nil:
+		err := json.NewEncoder(body).Encode(cs.Body)
+		if err != nil {
+			return nil, err
+		}
+		contentType = "application/json"
+	}
+
+	req := httptest.NewRequest(cs.Method, c.spec.BasePath+cs.Path, body)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if cs.Auth != "" {
+		req.Header.Set("auth", cs.Auth)
+	}
+	return req, nil
+}
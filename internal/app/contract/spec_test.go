@@ -0,0 +1,210 @@
+package contract
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Spec is the part of a Swagger 2.0 document that swag generates and the check needs.
+type Spec struct {
+	BasePath    string                          `json:"basePath"`
+	Paths       map[string]map[string]Operation `json:"paths"`
+	Definitions map[string]*Schema              `json:"definitions"`
+}
+
+type Operation struct {
+	Responses map[string]Response `json:"responses"`
+}
+
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema"`
+}
+
+type Schema struct {
+	Ref        string             `json:"$ref"`
+	Type       string             `json:"type"`
+	Format     string             `json:"format"`
+	Properties map[string]*Schema `json:"properties"`
+	Required   []string           `json:"required"`
+	Items      *Schema            `json:"items"`
+	AllOf      []*Schema          `json:"allOf"`
+}
+
+func ParseSpec(doc []byte) (*Spec, error) {
+	spec := &Spec{}
+	err := json.Unmarshal(doc, spec)
+	if err != nil {
+		return nil, fmt.Errorf("swagger: %w", err)
+	}
+	return spec, nil
+}
+
+// Operation finds the operation documented for method on path, a path relative to BasePath
+// with {name} parameters.
+func (s *Spec) Operation(method string, path string) (Operation, bool) {
+	op, ok := s.Paths[path][strings.ToLower(method)]
+	return op, ok
+}
+
+// Validate checks a decoded JSON value against schema and describes every mismatch.
+// A field the schema doesn't list is a mismatch too, so that a new field can't go undocumented.
+// at is the location of value used in the descriptions.
+func (s *Spec) Validate(schema *Schema, value interface{}, at string) []string {
+	schema, err := s.resolve(schema)
+	if err != nil {
+		return []string{at + ": " + err.Error()}
+	}
+
+	if value == nil {
+		if schema.Type == "" {
+			return nil
+		}
+		return []string{at + ": is null, want " + schema.Type}
+	}
+
+	switch schema.Type {
+	case "":
+		return nil
+	case "object":
+		return s.validateObject(schema, value, at)
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return []string{at + ": want array, got " + jsonType(value)}
+		}
+		var problems []string
+		for i, item := range items {
+			problems = append(problems, s.Validate(schema.Items, item, fmt.Sprintf("%s[%d]", at, i))...)
+		}
+		return problems
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return []string{at + ": want string, got " + jsonType(value)}
+		}
+		if schema.Format == "date-time" {
+			_, err := time.Parse(time.RFC3339Nano, str)
+			if err != nil {
+				return []string{at + ": " + str + " is not a date-time"}
+			}
+		}
+		return nil
+	case "integer":
+		number, ok := value.(float64)
+		if !ok || number != math.Trunc(number) {
+			return []string{at + ": want integer, got " + jsonType(value)}
+		}
+		return nil
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return []string{at + ": want number, got " + jsonType(value)}
+		}
+		return nil
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return []string{at + ": want boolean, got " + jsonType(value)}
+		}
+		return nil
+	default:
+		return []string{at + ": unsupported schema type " + schema.Type}
+	}
+}
+
+func (s *Spec) validateObject(schema *Schema, value interface{}, at string) []string {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return []string{at + ": want object, got " + jsonType(value)}
+	}
+
+	var problems []string
+	for _, name := range schema.Required {
+		field, ok := object[name]
+		if !ok {
+			problems = append(problems, at+"."+name+": is required but missing")
+		} else if field == nil {
+			problems = append(problems, at+"."+name+": is required but null")
+		}
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property, ok := schema.Properties[name]
+		if !ok {
+			problems = append(problems, at+"."+name+": is not documented")
+			continue
+		}
+		if object[name] == nil {
+			continue
+		}
+		problems = append(problems, s.Validate(property, object[name], at+"."+name)...)
+	}
+
+	return problems
+}
+
+// resolve follows $ref and merges allOf into one schema, later parts overriding the properties of earlier ones,
+// which is how swag describes a generic envelope like pagination.Response{items=[]models.Club}.
+func (s *Spec) resolve(schema *Schema) (*Schema, error) {
+	if schema == nil {
+		return &Schema{}, nil
+	}
+
+	for i := 0; schema.Ref != ""; i++ {
+		if i > 10 {
+			return nil, fmt.Errorf("$ref loop at %s", schema.Ref)
+		}
+		name := strings.TrimPrefix(schema.Ref, "#/definitions/")
+		def, ok := s.Definitions[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown definition %s", schema.Ref)
+		}
+		schema = def
+	}
+
+	if len(schema.AllOf) == 0 {
+		return schema, nil
+	}
+
+	merged := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for _, part := range schema.AllOf {
+		part, err := s.resolve(part)
+		if err != nil {
+			return nil, err
+		}
+		for name, property := range part.Properties {
+			merged.Properties[name] = property
+		}
+		merged.Required = append(merged.Required, part.Required...)
+	}
+
+	return merged, nil
+}
+
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
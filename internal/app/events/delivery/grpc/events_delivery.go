@@ -0,0 +1,143 @@
+package delivery
+
+import (
+	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+	"github.com/dantedoyl/car-life-api/internal/app/events"
+	"github.com/dantedoyl/car-life-api/internal/app/grpcapi"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/pkg/carlifepb"
+)
+
+type EventsServer struct {
+	carlifepb.UnimplementedEventsServiceServer
+	eventsUcase events.IEventsUsecase
+	authUcase   authorization.IAuthorizationUsecase
+}
+
+func NewEventsServer(eventsUcase events.IEventsUsecase, authUcase authorization.IAuthorizationUsecase) *EventsServer {
+	return &EventsServer{
+		eventsUcase: eventsUcase,
+		authUcase:   authUcase,
+	}
+}
+
+func (es *EventsServer) GetEvent(ctx context.Context, req *carlifepb.GetEventRequest) (*carlifepb.Event, error) {
+	userID, authed := ctx.Value("userID").(uint64)
+
+	event, err := es.eventsUcase.GetEventByID(req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toEvent(event, authed), nil
+}
+
+func (es *EventsServer) GetEvents(ctx context.Context, req *carlifepb.GetEventsRequest) (*carlifepb.GetEventsResponse, error) {
+	err := grpcapi.CheckIDs("ids", req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	userID, authed := ctx.Value("userID").(uint64)
+
+	found, err := es.eventsUcase.GetEventsByIDs(req.Ids, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.GetEventsResponse{Events: make([]*carlifepb.Event, 0, len(found))}
+	for _, event := range found {
+		resp.Events = append(resp.Events, toEvent(event, authed))
+	}
+	return resp, nil
+}
+
+func (es *EventsServer) ListEvents(ctx context.Context, req *carlifepb.ListEventsRequest) (*carlifepb.EventList, error) {
+	page, err := grpcapi.Page(req.Page)
+	if err != nil {
+		return nil, err
+	}
+	var query *string
+	if req.Query != "" {
+		query = &req.Query
+	}
+	var downLeftLongitude, downLeftLatitude, upperRightLongitude, upperRightLatitude *float32
+	if area := req.Area; area != nil {
+		downLeftLongitude, downLeftLatitude = &area.DownLeftLongitude, &area.DownLeftLatitude
+		upperRightLongitude, upperRightLatitude = &area.UpperRightLongitude, &area.UpperRightLatitude
+	}
+
+	found, next, err := es.eventsUcase.GetEvents(page, query, downLeftLongitude, downLeftLatitude, upperRightLongitude, upperRightLatitude)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.EventList{Items: make([]*carlifepb.Event, 0, len(found))}
+	for _, event := range found {
+		resp.Items = append(resp.Items, toEvent(event, false))
+	}
+	resp.NextCursor, resp.HasMore = grpcapi.NextCursor(next)
+	return resp, nil
+}
+
+func (es *EventsServer) ListEventMembers(ctx context.Context, req *carlifepb.ListEventMembersRequest) (*carlifepb.UserCardList, error) {
+	err := grpcapi.CheckStatus(req.Status, "admin", "participant", "spectator", "participant_request")
+	if err != nil {
+		return nil, err
+	}
+	page, err := grpcapi.Page(req.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Status == "participant_request" {
+		userID, ok := ctx.Value("userID").(uint64)
+		if !ok {
+			return nil, apperrors.Unauthorized("you're unauthorized")
+		}
+		err = es.authUcase.Can(userID, authorization.ActionEventViewRequests, req.EventId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cards, next, err := es.eventsUcase.GetEventsUserByStatus(int64(req.EventId), req.Status, page)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.UserCardList{Items: grpcapi.UserCards(cards)}
+	resp.NextCursor, resp.HasMore = grpcapi.NextCursor(next)
+	return resp, nil
+}
+
+// toEvent leaves out what the event wasn't loaded with: the creator of a list item
+// and the user status of a call without a session.
+func toEvent(event *models.Event, authed bool) *carlifepb.Event {
+	pb := &carlifepb.Event{
+		Id:   event.ID,
+		Name: event.Name,
+		Club: &carlifepb.ClubCard{
+			Id:                event.Club.ID,
+			Name:              event.Club.Name,
+			AvatarUrl:         event.Club.AvatarUrl,
+			Tags:              event.Club.Tags,
+			ParticipantsCount: int64(event.Club.ParticipantsCount),
+		},
+		Description:       event.Description,
+		EventDate:         grpcapi.Timestamp(event.EventDate),
+		Latitude:          event.Latitude,
+		Longitude:         event.Longitude,
+		AvatarUrl:         event.AvatarUrl,
+		ParticipantsCount: int64(event.ParticipantsCount),
+		SpectatorsCount:   int64(event.SpectatorsCount),
+		UpdatedAt:         grpcapi.Timestamp(event.UpdatedAt),
+	}
+	if event.Creator.VKID != 0 {
+		pb.Creator = grpcapi.UserCard(event.Creator)
+	}
+	if authed {
+		pb.UserStatus = event.UserStatus
+	}
+	return pb
+}
@@ -3,11 +3,14 @@ package delivery
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
 	"github.com/dantedoyl/car-life-api/internal/app/clients/vk"
-	clubs "github.com/dantedoyl/car-life-api/internal/app/clubs"
 	"github.com/dantedoyl/car-life-api/internal/app/events"
 	"github.com/dantedoyl/car-life-api/internal/app/middleware"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/ratelimit"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"github.com/dantedoyl/car-life-api/internal/app/utils"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/schema"
@@ -17,30 +20,53 @@ import (
 
 type EventsHandler struct {
 	eventsUcase events.IEventsUsecase
-	clubUcase   clubs.IClubsUsecase
-	vk          *vk.VKClient
+	authUcase   authorization.IAuthorizationUsecase
+	vk          vk.Client
+	appURL      string
 }
 
-func NewEventsHandler(eventsUcase events.IEventsUsecase, clubUcase clubs.IClubsUsecase, vk *vk.VKClient) *EventsHandler {
+func NewEventsHandler(eventsUcase events.IEventsUsecase, authUcase authorization.IAuthorizationUsecase, vk vk.Client, appURL string) *EventsHandler {
 	return &EventsHandler{
 		eventsUcase: eventsUcase,
-		clubUcase:   clubUcase,
+		authUcase:   authUcase,
 		vk:          vk,
+		appURL:      appURL,
 	}
 }
 
 func (eh *EventsHandler) Configure(r *mux.Router, mw *middleware.Middleware) {
-	r.HandleFunc("/event/create", mw.CheckAuthMiddleware(eh.CreateEvent)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/events/{id:[0-9]+}", mw.CheckAuthMiddleware(eh.GetEventByID)).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/events", mw.CheckAuthMiddleware(eh.GetEvents)).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/events/{id:[0-9]+}/upload", mw.CheckAuthMiddleware(eh.UploadAvatarHandler)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/events/{id:[0-9]+}/{type:participant|participant_request|spectator}", mw.CheckAuthMiddleware(eh.GetEventsUsersByType)).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/events/{id:[0-9]+}/{type:participate|spectate}", mw.CheckAuthMiddleware(eh.SetUserStatusByEventID)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/events/{eid:[0-9]+}/participate/{uid:[0-9]+}/{type:approve|reject}", mw.CheckAuthMiddleware(eh.ApproveRejectUserParticipateInEvent)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/events/{id:[0-9]+}/chat_link", mw.CheckAuthMiddleware(eh.GetEventChatLink)).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/event/create", mw.CheckAuthMiddleware(mw.Idempotent(eh.CreateEvent))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(eh.GetEventByID))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/events", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(eh.GetEvents))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/upload", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassUploads, mw.RequirePermission(authorization.ActionEventUpdate, "id", eh.UploadAvatarHandler)))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/{type:participant_request}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionEventViewRequests, "id", eh.GetEventsUsersByType)))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/{type:participant|spectator}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(eh.GetEventsUsersByType))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/{type:participate|spectate}", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassParticipation, eh.SetUserStatusByEventID))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/events/{eid:[0-9]+}/participate/{uid:[0-9]+}/{type:approve|reject}", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionEventManageParticipants, "eid", eh.ApproveRejectUserParticipateInEvent))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/chat_link", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionEventViewChat, "id", eh.GetEventChatLink))).Methods(http.MethodGet, http.MethodOptions)
 	r.HandleFunc("/events/{id:[0-9]+}/leave", mw.CheckAuthMiddleware(eh.LeaveEvent)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/events/{id:[0-9]+}/delete", mw.CheckAuthMiddleware(eh.DeleteEvent)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/events/{id:[0-9]+}/complain", mw.CheckAuthMiddleware(eh.ComplainEvent)).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/delete", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionEventDelete, "id", eh.DeleteEvent))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/complain", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassComplaints, eh.ComplainEvent))).Methods(http.MethodPost, http.MethodOptions)
+}
+
+// ConfigureV2 registers the v2 routes: plural resources, REST verbs instead of /delete and /leave,
+// and the member lists and requests as collections of their own.
+func (eh *EventsHandler) ConfigureV2(r *mux.Router, mw *middleware.Middleware) {
+	r.HandleFunc("/events", mw.CheckAuthMiddleware(mw.Idempotent(eh.CreateEventV2))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/events", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(eh.GetEvents))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(eh.GetEventByID))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionEventDelete, "id", eh.DeleteEvent))).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/avatar", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassUploads, mw.RequirePermission(authorization.ActionEventUpdate, "id", eh.UploadAvatarHandler)))).Methods(http.MethodPut, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/participants", middleware.ETag(middleware.CacheRevalidate, middleware.WithVars(map[string]string{"type": "participant"}, mw.CheckAuthMiddleware(eh.GetEventsUsersByType)))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/spectators", middleware.ETag(middleware.CacheRevalidate, middleware.WithVars(map[string]string{"type": "spectator"}, mw.CheckAuthMiddleware(eh.GetEventsUsersByType)))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/spectators", middleware.WithVars(map[string]string{"type": "spectate"}, mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassParticipation, eh.SetUserStatusByEventID)))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/participant_requests", middleware.ETag(middleware.CacheRevalidate, middleware.WithVars(map[string]string{"type": "participant_request"}, mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionEventViewRequests, "id", eh.GetEventsUsersByType))))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/participant_requests", middleware.WithVars(map[string]string{"type": "participate"}, mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassParticipation, eh.SetUserStatusByEventID)))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/events/{eid:[0-9]+}/participant_requests/{uid:[0-9]+}", middleware.WithVars(map[string]string{"type": "reject"}, mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionEventManageParticipants, "eid", eh.ApproveRejectUserParticipateInEvent)))).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/events/{eid:[0-9]+}/participants/{uid:[0-9]+}", middleware.WithVars(map[string]string{"type": "approve"}, mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionEventManageParticipants, "eid", eh.ApproveRejectUserParticipateInEvent)))).Methods(http.MethodPut, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/membership", mw.CheckAuthMiddleware(eh.LeaveEvent)).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/chat_link", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionEventViewChat, "id", eh.GetEventChatLink))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/events/{id:[0-9]+}/complaints", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassComplaints, eh.ComplainEvent))).Methods(http.MethodPost, http.MethodOptions)
 }
 
 // CreateEvent godoc
@@ -50,45 +76,74 @@ func (eh *EventsHandler) Configure(r *mux.Router, mw *middleware.Middleware) {
 // @Accept       json
 // @Produce      json
 // @Param        body body models.CreateEventRequest true "Event"
+// @Param        Idempotency-Key header string false "Replays the first response to a retry with the same key"
 // @Success      200  {object}  models.Event
 // @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /event/create [post]
 func (eh *EventsHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
+	eventsData, ok := eh.createEvent(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := json.Marshal(eventsData)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// CreateEventV2 is CreateEvent of API v2: it answers 201 with the new event in the Location header.
+func (eh *EventsHandler) CreateEventV2(w http.ResponseWriter, r *http.Request) {
+	eventsData, ok := eh.createEvent(w, r)
+	if !ok {
+		return
+	}
+
+	utils.WriteCreated(w, r, r.URL.Path+"/"+strconv.FormatUint(eventsData.ID, 10), eventsData)
+}
+
+// createEvent creates the event from the request body. On failure it writes the error and returns false.
+func (eh *EventsHandler) createEvent(w http.ResponseWriter, r *http.Request) (*models.Event, bool) {
 	defer r.Body.Close()
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
-		return
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+		return nil, false
 	}
 
 	event := &models.CreateEventRequest{}
 	err := json.NewDecoder(r.Body).Decode(&event)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't unmarshal data"}))
-		return
+		utils.WriteError(w, r, apperrors.Validation("can't unmarshal data"))
+		return nil, false
 	}
 
-	userClubSatus, err := eh.clubUcase.GetUserStatusInClub(int64(event.ClubID), int64(userID))
+	err = event.Validate()
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
-	if userClubSatus == nil || userClubSatus.Status != "admin" {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "user has inappropriate status"}))
-		return
+	err = eh.authUcase.Can(userID, authorization.ActionClubCreateEvent, event.ClubID)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
 	eventsData := &models.Event{
 		Name:        event.Name,
-		Club:        models.Club{ID: event.ClubID},
+		Club:        models.Club{ID: event.ClubID, Tags: []string{}},
 		Description: event.Description,
 		EventDate:   event.EventDate,
 		Latitude:    event.Latitude,
@@ -101,36 +156,23 @@ func (eh *EventsHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 
 	err = eh.eventsUcase.CreateEvent(eventsData)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
-	id, err := eh.vk.CreatChat(eventsData.Name)
+	id, err := eh.vk.CreatChat(r.Context(), eventsData.Name)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
 	err = eh.eventsUcase.SetEventChatID(int64(eventsData.ID), int64(id))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
-	body, err := json.Marshal(eventsData)
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-
-	w.WriteHeader(http.StatusOK)
-	w.Write(body)
+	return eventsData, true
 }
 
 // GetEvents godoc
@@ -139,15 +181,14 @@ func (eh *EventsHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 // @Tags         Events
 // @Accept       json
 // @Produce      json
-// @Param        IdGt query integer false "IdGt"
-// @Param        IdLte query integer false "IdLte"
+// @Param        Cursor query string false "Cursor"
 // @Param        Limit query integer false "Limit"
 // @Param        Query query string false "Query"
 // @Param        UpperRightLatitude query number false "UpperRightLatitude"
 // @Param        UpperRightLongitude query number false "UpperRightLongitude"
 // @Param        DownLeftLatitude query number false "DownLeftLatitude"
 // @Param        DownLeftLongitude query number false "DownLeftLongitude"
-// @Success      200  {object}  []models.EventCard
+// @Success      200  {object}  pagination.Response{items=[]models.EventCard}
 // @Failure      400  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
@@ -158,15 +199,19 @@ func (eh *EventsHandler) GetEvents(w http.ResponseWriter, r *http.Request) {
 	decoder.IgnoreUnknownKeys(true)
 	err := decoder.Decode(query, r.URL.Query())
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
+		return
+	}
+
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	events, err := eh.eventsUcase.GetEvents(query.IdGt, query.IdLte, query.Limit, query.Query, query.DownLeftLongitude, query.DownLeftLatitude, query.UpperRightLongitude, query.UpperRightLatitude)
+	events, next, err := eh.eventsUcase.GetEvents(page, query.Query, query.DownLeftLongitude, query.DownLeftLatitude, query.UpperRightLongitude, query.UpperRightLatitude)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 	if len(events) == 0 {
@@ -187,10 +232,9 @@ func (eh *EventsHandler) GetEvents(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
-	body, err := json.Marshal(eventCards)
+	body, err := json.Marshal(pagination.NewResponse(eventCards, next))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -223,15 +267,13 @@ func (eh *EventsHandler) GetEventByID(w http.ResponseWriter, r *http.Request) {
 
 	event, err := eh.eventsUcase.GetEventByID(eventID, userID)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	body, err := json.Marshal(event)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -250,6 +292,8 @@ func (eh *EventsHandler) GetEventByID(w http.ResponseWriter, r *http.Request) {
 // @Param 		 file-upload formData file true "Image to upload"
 // @Success      200  {object}  models.Event
 // @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /events/{id}/upload [post]
@@ -261,51 +305,44 @@ func (eh *EventsHandler) UploadAvatarHandler(w http.ResponseWriter, r *http.Requ
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	r.Body = http.MaxBytesReader(w, r.Body, 3*1024*1024)
 	err := r.ParseMultipartForm(3 * 1024 * 1024)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't parse data"}))
+		utils.WriteError(w, r, apperrors.Validation("can't parse data"))
 		return
 	}
 
 	if len(r.MultipartForm.File["file-upload"]) == 0 {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "no photo"}))
+		utils.WriteError(w, r, apperrors.Validation("no photo"))
 		return
 	}
 
 	file := r.MultipartForm.File["file-upload"][0]
 	event, err := eh.eventsUcase.UpdateAvatar(eventID, file)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	chatID, err := eh.eventsUcase.GetEventChatID(eventID, int64(userID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	err = eh.vk.UploadChatPhoto(int(chatID), file)
+	err = eh.vk.UploadChatPhoto(r.Context(), int(chatID), file)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	body, err := json.Marshal(event)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -321,12 +358,13 @@ func (eh *EventsHandler) UploadAvatarHandler(w http.ResponseWriter, r *http.Requ
 // @Accept       json
 // @Produce      json
 // @Param        id path int64 true "Event ID"
-// @Param        IdGt query integer false "IdGt"
-// @Param        IdLte query integer false "IdLte"
+// @Param        Cursor query string false "Cursor"
 // @Param        Limit query integer false "Limit"
 // @Param        type path string true "Type" Enums(participant, participant_request, spectator)
-// @Success      200  {object}  []models.UserCard
+// @Success      200  {object}  pagination.Response{items=[]models.UserCard}
 // @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /events/{id}/{type} [get]
@@ -340,25 +378,28 @@ func (eh *EventsHandler) GetEventsUsersByType(w http.ResponseWriter, r *http.Req
 	decoder.IgnoreUnknownKeys(true)
 	err := decoder.Decode(query, r.URL.Query())
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
 		return
 	}
 
-	users, err := eh.eventsUcase.GetEventsUserByStatus(int64(eventID), role, query.IdGt, query.IdLte, query.Limit)
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	users, next, err := eh.eventsUcase.GetEventsUserByStatus(int64(eventID), role, page)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 	if len(users) == 0 {
 		users = []*models.UserCard{}
 	}
 
-	body, err := json.Marshal(users)
+	body, err := json.Marshal(pagination.NewResponse(users, next))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -377,7 +418,7 @@ func (eh *EventsHandler) GetEventsUsersByType(w http.ResponseWriter, r *http.Req
 // @Param        type path string true "Type" Enums(participate, spectate)
 // @Success      200
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /events/{id}/{type} [post]
@@ -388,8 +429,7 @@ func (eh *EventsHandler) SetUserStatusByEventID(w http.ResponseWriter, r *http.R
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
@@ -400,26 +440,23 @@ func (eh *EventsHandler) SetUserStatusByEventID(w http.ResponseWriter, r *http.R
 
 	err := eh.eventsUcase.SetUserStatusByEventID(int64(eventID), int64(userID), status)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	if status == "participant_request" {
 		event, err := eh.eventsUcase.GetEventByID(eventID, userID)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+			utils.WriteError(w, r, err)
 			return
 		}
 
-		eventUrl := "https://vk.com/app8099557"
-		err = eh.vk.CreatMessage(int(event.Creator.VKID),
+		eventUrl := eh.appURL
+		err = eh.vk.CreatMessage(r.Context(), int(event.Creator.VKID),
 			fmt.Sprintf("Привет! Новый участник хочет поучаствовать в %s: %s", event.Name, eventUrl),
 		)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+			utils.WriteError(w, r, err)
 			return
 		}
 	}
@@ -438,7 +475,8 @@ func (eh *EventsHandler) SetUserStatusByEventID(w http.ResponseWriter, r *http.R
 // @Param        type path string true "Type" Enums(approve, reject)
 // @Success      200
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /events/{eid}/participate/{uid}/{type} [post]
@@ -448,38 +486,28 @@ func (eh *EventsHandler) ApproveRejectUserParticipateInEvent(w http.ResponseWrit
 	userID, _ := strconv.ParseUint(vars["uid"], 10, 64)
 	decision := vars["type"]
 
-	_, ok := r.Context().Value("userID").(uint64)
-	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
-		return
-	}
-
 	err := eh.eventsUcase.ApproveRejectUserParticipateInEvent(int64(eventID), int64(userID), decision)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	event, err := eh.eventsUcase.GetEventByID(eventID, userID)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	eventUrl := "https://vk.com/app8099557"
+	eventUrl := eh.appURL
 
 	msg := fmt.Sprintf("Привет! Администратор принял вас в %s: %s\n", event.Name, eventUrl)
 	if decision == "reject" {
 		msg = fmt.Sprintf("Привет! К сожалению, администратор отклонил ваш запрос на участие в %s: %s\n", event.Name, eventUrl)
 	}
 
-	err = eh.vk.CreatMessage(int(userID), msg)
+	err = eh.vk.CreatMessage(r.Context(), int(userID), msg)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -495,7 +523,8 @@ func (eh *EventsHandler) ApproveRejectUserParticipateInEvent(w http.ResponseWrit
 // @Param        id path int64 true "Event ID"
 // @Success      200  {object}  models.ChatLink
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /events/{id}/chat_link [get]
@@ -505,35 +534,30 @@ func (eh *EventsHandler) GetEventChatLink(w http.ResponseWriter, r *http.Request
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	chatID, err := eh.eventsUcase.GetEventChatID(int64(eventID), int64(userID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	if chatID == 0 {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "no chat for this club"}))
+		utils.WriteError(w, r, apperrors.Validation("no chat for this club"))
 		return
 	}
 
-	chatLink, err := eh.vk.GetChatLink(int(chatID))
+	chatLink, err := eh.vk.GetChatLink(r.Context(), int(chatID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	body, err := json.Marshal(models.ChatLink{ChatLink: chatLink})
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -551,7 +575,7 @@ func (eh *EventsHandler) GetEventChatLink(w http.ResponseWriter, r *http.Request
 // @Param        id path int64 true "Event ID"
 // @Success      200
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /events/{id}/leave [post]
@@ -561,15 +585,13 @@ func (eh *EventsHandler) LeaveEvent(w http.ResponseWriter, r *http.Request) {
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	err := eh.eventsUcase.DeleteUserFromEvent(int64(clubID), int64(userID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -586,6 +608,7 @@ func (eh *EventsHandler) LeaveEvent(w http.ResponseWriter, r *http.Request) {
 // @Success      200
 // @Failure      400  {object}  utils.Error
 // @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /events/{id}/delete [post]
@@ -593,30 +616,9 @@ func (eh *EventsHandler) DeleteEvent(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	clubID, _ := strconv.ParseUint(vars["id"], 10, 64)
 
-	userID, ok := r.Context().Value("userID").(uint64)
-	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
-		return
-	}
-
-	event, err := eh.eventsUcase.GetEventByID(clubID, userID)
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
-	}
-
-	if event.Creator.VKID != userID {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "user has inappropriate status"}))
-		return
-	}
-
-	err = eh.eventsUcase.DeleteEventByID(int64(clubID))
+	err := eh.eventsUcase.DeleteEventByID(int64(clubID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -643,16 +645,20 @@ func (ch *EventsHandler) ComplainEvent(w http.ResponseWriter, r *http.Request) {
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	req := &models.ComplaintReq{}
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't unmarshal data"}))
+		utils.WriteError(w, r, apperrors.Validation("can't unmarshal data"))
+		return
+	}
+
+	err = req.Validate()
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -662,8 +668,7 @@ func (ch *EventsHandler) ComplainEvent(w http.ResponseWriter, r *http.Request) {
 		TargetID: int64(clubID),
 	})
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
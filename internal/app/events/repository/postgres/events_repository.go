@@ -2,10 +2,13 @@ package events_repository
 
 import (
 	"database/sql"
+	"github.com/dantedoyl/car-life-api/internal/app/clients/database"
 	"github.com/dantedoyl/car-life-api/internal/app/events"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"github.com/lib/pq"
 	"strconv"
+	"time"
 )
 
 type EventsRepository struct {
@@ -23,14 +26,14 @@ func (er *EventsRepository) InsertEvent(event *models.Event) error {
 		`INSERT INTO events
                 (name, club_id, creator_id, description, event_date, latitude, longitude, participants_count)
                 VALUES ($1, $2, $3, $4, $5, $6, $7, 1) 
-                RETURNING id`,
+                RETURNING id, updated_at`,
 		event.Name,
 		event.Club.ID,
 		event.Creator.VKID,
 		event.Description,
 		event.EventDate,
 		event.Latitude,
-		event.Longitude).Scan(&event.ID)
+		event.Longitude).Scan(&event.ID, &event.UpdatedAt)
 	if err != nil {
 		return err
 	}
@@ -56,16 +59,19 @@ func (er *EventsRepository) InsertEvent(event *models.Event) error {
 func (er *EventsRepository) GetEventByID(id int64, userID uint64) (*models.Event, error) {
 	event := &models.Event{}
 	err := er.dbConn.QueryRow(
-		`SELECT  id, name, club_id, creator_id, description, event_date, latitude, longitude, avatar, participants_count, spectators_count from events
-				WHERE id = $1`, id).Scan(&event.ID, &event.Name, &event.Club.ID, &event.Creator.VKID, &event.Description, &event.EventDate,
-		&event.Latitude, &event.Longitude, &event.AvatarUrl, &event.ParticipantsCount, &event.SpectatorsCount)
+		`SELECT  id, name, club_id, creator_id, description, event_date, latitude, longitude, avatar, participants_count, spectators_count, updated_at from events
+				WHERE id = $1 and `+database.Visible("events", 2), id, userID).Scan(&event.ID, &event.Name, &event.Club.ID, &event.Creator.VKID, &event.Description, &event.EventDate,
+		&event.Latitude, &event.Longitude, &event.AvatarUrl, &event.ParticipantsCount, &event.SpectatorsCount, &event.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, events.ErrEventNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	err = er.dbConn.QueryRow(
-		`SELECT  c.id, c.name, c.tags, c.participants_count, c.avatar from clubs as c
-				WHERE c.id = $1`, event.Club.ID).Scan(&event.Club.ID, &event.Club.Name, pq.Array(&event.Club.Tags), &event.Club.ParticipantsCount, &event.Club.AvatarUrl)
+		`SELECT  c.id, c.name, c.tags, c.participants_count, c.avatar, c.updated_at from clubs as c
+				WHERE c.id = $1`, event.Club.ID).Scan(&event.Club.ID, &event.Club.Name, pq.Array(&event.Club.Tags), &event.Club.ParticipantsCount, &event.Club.AvatarUrl, &event.Club.UpdatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -95,24 +101,47 @@ func (er *EventsRepository) GetEventByID(id int64, userID uint64) (*models.Event
 	return event, nil
 }
 
-func (er *EventsRepository) GetEvents(idGt *uint64, idLte *uint64, limit *uint64, query *string, downLeftLongitude *float32, downLeftLatitude *float32, upperRightLongitude *float32, upperRightLatitude *float32) ([]*models.Event, error) {
-	var events []*models.Event
-	ind := 1
-	var values []interface{}
-	q := `SELECT id, name, club_id, description, event_date, latitude, longitude, avatar, participants_count, spectators_count from events
-        	WHERE event_date >= now() `
-
-	if idGt != nil {
-		q += ` AND id > $` + strconv.Itoa(ind)
-		values = append(values, idGt)
-		ind++
+// GetEventsByIDs loads what GetEventByID does with one query for all the events.
+// The status of a user with no relation to an event is "unknown", like in GetEventByID.
+func (er *EventsRepository) GetEventsByIDs(ids []uint64, userID uint64) ([]*models.Event, error) {
+	rows, err := er.dbConn.Query(
+		`SELECT e.id, e.name, e.description, e.event_date, e.latitude, e.longitude, e.avatar, e.participants_count, e.spectators_count, e.updated_at,
+       			c.id, c.name, c.tags, c.participants_count, c.avatar, c.updated_at,
+       			u.vk_id, u.name, u.surname, u.avatar, COALESCE(s.status, 'unknown') from events as e
+				inner join clubs as c on c.id = e.club_id
+				inner join users as u on u.vk_id = e.creator_id
+				left join users_events as s on s.event_id = e.id and s.user_id = $2
+				WHERE e.id = ANY($1) and `+database.Visible("e", 2), pq.Array(ids), userID)
+	if err != nil {
+		return nil, err
 	}
 
-	if idLte != nil {
-		q += ` AND id <= $` + strconv.Itoa(ind)
-		values = append(values, idLte)
-		ind++
+	defer rows.Close()
+
+	var found []*models.Event
+	for rows.Next() {
+		event := &models.Event{}
+		err = rows.Scan(&event.ID, &event.Name, &event.Description, &event.EventDate, &event.Latitude, &event.Longitude, &event.AvatarUrl,
+			&event.ParticipantsCount, &event.SpectatorsCount, &event.UpdatedAt,
+			&event.Club.ID, &event.Club.Name, pq.Array(&event.Club.Tags), &event.Club.ParticipantsCount, &event.Club.AvatarUrl, &event.Club.UpdatedAt,
+			&event.Creator.VKID, &event.Creator.Name, &event.Creator.Surname, &event.Creator.AvatarUrl, &event.UserStatus)
+		if err != nil {
+			return nil, err
+		}
+		if userID == 0 {
+			event.UserStatus = ""
+		}
+		found = append(found, event)
 	}
+	return found, rows.Err()
+}
+
+func (er *EventsRepository) GetEvents(page pagination.Page, query *string, downLeftLongitude *float32, downLeftLatitude *float32, upperRightLongitude *float32, upperRightLatitude *float32) ([]*models.Event, *pagination.Cursor, error) {
+	var events []*models.Event
+	ind := 1
+	var values []interface{}
+	q := `SELECT id, name, club_id, description, event_date, latitude, longitude, avatar, participants_count, spectators_count, created_at from events
+        	WHERE event_date >= now() AND hidden = false `
 
 	if query != nil {
 		q += ` AND lower(name) like '%' || lower($` + strconv.Itoa(ind) + `) || '%'`
@@ -127,85 +156,97 @@ func (er *EventsRepository) GetEvents(idGt *uint64, idLte *uint64, limit *uint64
 		ind = ind + 4
 	}
 
-	if limit != nil {
-		q += ` LIMIT $` + strconv.Itoa(ind)
-		values = append(values, limit)
+	if page.After != nil {
+		createdAt, err := page.After.Time()
+		if err != nil {
+			return nil, nil, err
+		}
+		q += ` AND (created_at, id) < ($` + strconv.Itoa(ind) + `, $` + strconv.Itoa(ind+1) + `)`
+		values = append(values, createdAt, page.After.ID)
+		ind += 2
 	}
 
-	q += ` ORDER BY created_at desc`
+	q += ` ORDER BY created_at desc, id desc LIMIT $` + strconv.Itoa(ind)
+	values = append(values, page.FetchLimit())
 	rows, err := er.dbConn.Query(q, values...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rows.Close()
 
+	var last, next *pagination.Cursor
 	for rows.Next() {
 		event := &models.Event{}
+		var createdAt time.Time
 		err = rows.Scan(&event.ID, &event.Name, &event.Club.ID, &event.Description, &event.EventDate,
-			&event.Latitude, &event.Longitude, &event.AvatarUrl, &event.ParticipantsCount, &event.SpectatorsCount)
+			&event.Latitude, &event.Longitude, &event.AvatarUrl, &event.ParticipantsCount, &event.SpectatorsCount, &createdAt)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if page.Full(len(events)) {
+			next = last
+			break
 		}
 		events = append(events, event)
+		last = &pagination.Cursor{Key: pagination.TimeKey(createdAt), ID: event.ID}
 	}
-	return events, nil
+	return events, next, rows.Err()
 }
 
 func (er *EventsRepository) UpdateEvent(event *models.Event) (*models.Event, error) {
 	err := er.dbConn.QueryRow(
 		`UPDATE events SET name = $1, description = $2, event_date = $3, latitude = $4, longitude = $5, avatar = $6
 				WHERE id = $7
-				RETURNING id, name, club_id, description, event_date, latitude, longitude, avatar, participants_count, spectators_count`,
+				RETURNING id, name, club_id, description, event_date, latitude, longitude, avatar, participants_count, spectators_count, updated_at`,
 		event.Name, event.Description, event.EventDate, event.Latitude, event.Longitude, event.AvatarUrl, event.ID).Scan(&event.ID, &event.Name, &event.Club.ID, &event.Description, &event.EventDate,
-		&event.Latitude, &event.Longitude, &event.AvatarUrl, &event.ParticipantsCount, &event.SpectatorsCount)
+		&event.Latitude, &event.Longitude, &event.AvatarUrl, &event.ParticipantsCount, &event.SpectatorsCount, &event.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, events.ErrEventNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
 	return event, nil
 }
 
-func (er *EventsRepository) GetEventsUserByStatus(event_id int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.UserCard, error) {
+func (er *EventsRepository) GetEventsUserByStatus(event_id int64, status string, page pagination.Page) ([]*models.UserCard, *pagination.Cursor, error) {
 	var users []*models.UserCard
 	ind := 3
 	var values []interface{}
 	values = append(values, status, event_id)
 	q := `SELECT u.vk_id, u.name, u.surname, u.avatar from users_events as ue INNER JOIN users as u on u.vk_id = ue.user_id WHERE ue.status = $1 and ue.event_id=$2`
 
-	if idGt != nil {
-		q += ` AND u.vk_id > $` + strconv.Itoa(ind)
-		values = append(values, idGt)
-		ind++
-	}
-
-	if idLte != nil {
-		q += ` AND u.vk_id <= $` + strconv.Itoa(ind)
-		values = append(values, idLte)
-		ind++
-	}
-
-	if limit != nil {
-		q += ` LIMIT $` + strconv.Itoa(ind)
-		values = append(values, limit)
+	if page.After != nil {
+		q += ` AND (u.surname, u.vk_id) < ($` + strconv.Itoa(ind) + `, $` + strconv.Itoa(ind+1) + `)`
+		values = append(values, page.After.Key, page.After.ID)
+		ind += 2
 	}
 
-	q += ` ORDER BY u.surname desc`
+	q += ` ORDER BY u.surname desc, u.vk_id desc LIMIT $` + strconv.Itoa(ind)
+	values = append(values, page.FetchLimit())
 	rows, err := er.dbConn.Query(q, values...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rows.Close()
 
+	var last, next *pagination.Cursor
 	for rows.Next() {
 		user := &models.UserCard{}
 		err = rows.Scan(&user.VKID, &user.Name, &user.Surname, &user.AvatarUrl)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if page.Full(len(users)) {
+			next = last
+			break
 		}
 		users = append(users, user)
+		last = &pagination.Cursor{Key: user.Surname, ID: user.VKID}
 	}
-	return users, nil
+	return users, next, rows.Err()
 }
 
 func (er *EventsRepository) SetUserStatusByEventID(eventID int64, userID int64, status string) error {
@@ -257,6 +298,9 @@ func (er *EventsRepository) SetEventChatID(eventID int64, chatID int64) error {
 func (er *EventsRepository) DeleteUserFromEvent(eventID int64, userID int64) error {
 	var status string
 	err := er.dbConn.QueryRow(`DELETE FROM users_events WHERE event_id = $1 and user_id = $2 RETURNING status`, eventID, userID).Scan(&status)
+	if err == sql.ErrNoRows {
+		return events.ErrNotEventMember
+	}
 	if err != nil {
 		return err
 	}
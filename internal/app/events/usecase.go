@@ -1,16 +1,26 @@
 package events
 
 import (
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"mime/multipart"
 )
 
+var (
+	ErrEventNotFound  = apperrors.NotFound("event not found")
+	ErrNotEventMember = apperrors.NotFound("user is not a member of the event")
+)
+
 type IEventsUsecase interface {
 	CreateEvent(event *models.Event) error
 	GetEventByID(id uint64, userID uint64) (*models.Event, error)
-	GetEvents(idGt *uint64, idLte *uint64, limit *uint64, query *string, downLeftLongitude *float32, downLeftLatitude *float32, upperRightLongitude *float32, upperRightLatitude *float32) ([]*models.Event, error)
+	// GetEventsByIDs is GetEventByID for many events at once. It skips the ids of missing events
+	// and returns the events in no particular order.
+	GetEventsByIDs(ids []uint64, userID uint64) ([]*models.Event, error)
+	GetEvents(page pagination.Page, query *string, downLeftLongitude *float32, downLeftLatitude *float32, upperRightLongitude *float32, upperRightLatitude *float32) ([]*models.Event, *pagination.Cursor, error)
 	UpdateAvatar(eventID int64, fileHeader *multipart.FileHeader) (*models.Event, error)
-	GetEventsUserByStatus(event_id int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.UserCard, error)
+	GetEventsUserByStatus(event_id int64, status string, page pagination.Page) ([]*models.UserCard, *pagination.Cursor, error)
 	SetUserStatusByEventID(eventID int64, userID int64, status string) error
 	ApproveRejectUserParticipateInEvent(eventID int64, userID int64, decision string) error
 	GetEventChatID(eventID int64, userID int64) (int64, error)
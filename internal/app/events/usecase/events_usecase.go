@@ -4,6 +4,7 @@ import (
 	"github.com/dantedoyl/car-life-api/internal/app/clients/filesystem"
 	"github.com/dantedoyl/car-life-api/internal/app/events"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"mime/multipart"
 )
 
@@ -25,8 +26,12 @@ func (eu *EventsUsecase) GetEventByID(id uint64, userID uint64) (*models.Event,
 	return eu.eventsRepo.GetEventByID(int64(id), userID)
 }
 
-func (eu *EventsUsecase) GetEvents(idGt *uint64, idLte *uint64, limit *uint64, query *string, downLeftLongitude *float32, downLeftLatitude *float32, upperRightLongitude *float32, upperRightLatitude *float32) ([]*models.Event, error) {
-	return eu.eventsRepo.GetEvents(idGt, idLte, limit, query, downLeftLongitude, downLeftLatitude, upperRightLongitude, upperRightLatitude)
+func (eu *EventsUsecase) GetEventsByIDs(ids []uint64, userID uint64) ([]*models.Event, error) {
+	return eu.eventsRepo.GetEventsByIDs(ids, userID)
+}
+
+func (eu *EventsUsecase) GetEvents(page pagination.Page, query *string, downLeftLongitude *float32, downLeftLatitude *float32, upperRightLongitude *float32, upperRightLatitude *float32) ([]*models.Event, *pagination.Cursor, error) {
+	return eu.eventsRepo.GetEvents(page, query, downLeftLongitude, downLeftLatitude, upperRightLongitude, upperRightLatitude)
 }
 
 func (eu *EventsUsecase) UpdateAvatar(eventID int64, fileHeader *multipart.FileHeader) (*models.Event, error) {
@@ -59,8 +64,8 @@ func (eu *EventsUsecase) UpdateAvatar(eventID int64, fileHeader *multipart.FileH
 	return event, nil
 }
 
-func (eu *EventsUsecase) GetEventsUserByStatus(event_id int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.UserCard, error) {
-	return eu.eventsRepo.GetEventsUserByStatus(event_id, status, idGt, idLte, limit)
+func (eu *EventsUsecase) GetEventsUserByStatus(event_id int64, status string, page pagination.Page) ([]*models.UserCard, *pagination.Cursor, error) {
+	return eu.eventsRepo.GetEventsUserByStatus(event_id, status, page)
 }
 
 func (eu *EventsUsecase) SetUserStatusByEventID(eventID int64, userID int64, status string) error {
@@ -0,0 +1,60 @@
+package delivery
+
+import (
+	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/events_posts"
+	"github.com/dantedoyl/car-life-api/internal/app/grpcapi"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/pkg/carlifepb"
+)
+
+type EventsPostsServer struct {
+	carlifepb.UnimplementedPostsServiceServer
+	eventsPostsUcase events_posts.IEventsPostsUsecase
+}
+
+func NewEventsPostsServer(eventsPostsUcase events_posts.IEventsPostsUsecase) *EventsPostsServer {
+	return &EventsPostsServer{
+		eventsPostsUcase: eventsPostsUcase,
+	}
+}
+
+func (eps *EventsPostsServer) GetPost(ctx context.Context, req *carlifepb.GetPostRequest) (*carlifepb.Post, error) {
+	userID, _ := ctx.Value("userID").(uint64)
+
+	post, err := eps.eventsPostsUcase.GetEventPostByPostID(req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toPost(post), nil
+}
+
+func (eps *EventsPostsServer) ListEventPosts(ctx context.Context, req *carlifepb.ListEventPostsRequest) (*carlifepb.PostList, error) {
+	page, err := grpcapi.Page(req.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, next, err := eps.eventsPostsUcase.GetEventsPostsByEventID(req.EventId, page)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.PostList{Items: make([]*carlifepb.Post, 0, len(posts))}
+	for _, post := range posts {
+		resp.Items = append(resp.Items, toPost(post))
+	}
+	resp.NextCursor, resp.HasMore = grpcapi.NextCursor(next)
+	return resp, nil
+}
+
+func toPost(post *models.EventPost) *carlifepb.Post {
+	return &carlifepb.Post{
+		Id:          post.ID,
+		Text:        post.Text,
+		Author:      grpcapi.UserCard(post.User),
+		EventId:     post.EventID,
+		CreatedAt:   grpcapi.Timestamp(post.CreatedAt),
+		Attachments: post.Attachments,
+	}
+}
@@ -2,9 +2,13 @@ package delivery
 
 import (
 	"encoding/json"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
 	"github.com/dantedoyl/car-life-api/internal/app/events_posts"
 	"github.com/dantedoyl/car-life-api/internal/app/middleware"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+	"github.com/dantedoyl/car-life-api/internal/app/ratelimit"
 	"github.com/dantedoyl/car-life-api/internal/app/utils"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/schema"
@@ -23,14 +27,74 @@ func NewEventsPostsHandler(eventsUcase events_posts.IEventsPostsUsecase) *Events
 }
 
 func (eph *EventsPostsHandler) Configure(r *mux.Router, mw *middleware.Middleware) {
-	r.HandleFunc("/event_posts/{event_id:[0-9]+}/create", mw.CheckAuthMiddleware(eph.CreateEventPost)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/event_posts/{event_id:[0-9]+}", mw.CheckAuthMiddleware(eph.GetEventsPostsByEventID)).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/events_posts/{post_id:[0-9]+}/upload", mw.CheckAuthMiddleware(eph.UploadAttachments)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/event_posts/{post_id:[0-9]+}/delete", mw.CheckAuthMiddleware(eph.DeletePost)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/event_posts/{post_id:[0-9]+}/complain", mw.CheckAuthMiddleware(eph.ComplainPost)).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/event_posts/{event_id:[0-9]+}/create", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassPosts, mw.Idempotent(mw.RequirePermission(authorization.ActionEventCreatePost, "event_id", eph.CreateEventPost))))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/event_posts/{event_id:[0-9]+}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(eph.GetEventsPostsByEventID))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/events_posts/{post_id:[0-9]+}/upload", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassUploads, mw.RequirePermission(authorization.ActionPostUpdate, "post_id", eph.UploadAttachments)))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/event_posts/{post_id:[0-9]+}/delete", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionPostDelete, "post_id", eph.DeletePost))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/event_posts/{post_id:[0-9]+}/complain", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassComplaints, eph.ComplainPost))).Methods(http.MethodPost, http.MethodOptions)
 
 }
 
+// ConfigureV2 registers the posts as a collection nested in their event.
+func (eph *EventsPostsHandler) ConfigureV2(r *mux.Router, mw *middleware.Middleware) {
+	r.HandleFunc("/events/{event_id:[0-9]+}/posts", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassPosts, mw.Idempotent(mw.RequirePermission(authorization.ActionEventCreatePost, "event_id", eph.CreateEventPostV2))))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/events/{event_id:[0-9]+}/posts", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(eph.GetEventsPostsByEventID))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/events/{event_id:[0-9]+}/posts/{post_id:[0-9]+}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(eph.GetEventPost))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/events/{event_id:[0-9]+}/posts/{post_id:[0-9]+}", mw.CheckAuthMiddleware(eph.postOfEvent(mw.RequirePermission(authorization.ActionPostDelete, "post_id", eph.DeletePost)))).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/events/{event_id:[0-9]+}/posts/{post_id:[0-9]+}/attachments", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassUploads, eph.postOfEvent(mw.RequirePermission(authorization.ActionPostUpdate, "post_id", eph.UploadAttachments))))).Methods(http.MethodPut, http.MethodOptions)
+	r.HandleFunc("/events/{event_id:[0-9]+}/posts/{post_id:[0-9]+}/complaints", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassComplaints, eph.postOfEvent(eph.ComplainPost)))).Methods(http.MethodPost, http.MethodOptions)
+}
+
+// postOfEvent answers 404 unless the post from the path belongs to the event from the path,
+// so that a nested v2 route can't reach a post through another event.
+func (eph *EventsPostsHandler) postOfEvent(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, err := eph.eventPost(r)
+		if err != nil {
+			utils.WriteError(w, r, err)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+}
+
+func (eph *EventsPostsHandler) eventPost(r *http.Request) (*models.EventPost, error) {
+	vars := mux.Vars(r)
+	eventID, _ := strconv.ParseUint(vars["event_id"], 10, 64)
+	postID, _ := strconv.ParseUint(vars["post_id"], 10, 64)
+	userID, _ := r.Context().Value("userID").(uint64)
+
+	post, err := eph.eventsUcase.GetEventPostByPostID(postID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if post.EventID != eventID {
+		return nil, events_posts.ErrPostNotFound
+	}
+
+	return post, nil
+}
+
+// GetEventPost answers GET /api/v2/events/{event_id}/posts/{post_id}, the Location of a created post.
+func (eph *EventsPostsHandler) GetEventPost(w http.ResponseWriter, r *http.Request) {
+	post, err := eph.eventPost(r)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	body, err := json.Marshal(post)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
 // CreateEventPost godoc
 // @Summary      create an event post
 // @Description  Handler for creating an event post
@@ -39,29 +103,65 @@ func (eph *EventsPostsHandler) Configure(r *mux.Router, mw *middleware.Middlewar
 // @Produce      json
 // @Param        event_id path int64 true "Event ID"
 // @Param        body body models.CreatePostRequest true "EventPost"
+// @Param        Idempotency-Key header string false "Replays the first response to a retry with the same key"
 // @Success      200  {object}  models.EventPost
 // @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /event_posts/{event_id}/create [post]
 func (eph *EventsPostsHandler) CreateEventPost(w http.ResponseWriter, r *http.Request) {
+	eventsData, ok := eph.createEventPost(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := json.Marshal(eventsData)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// CreateEventPostV2 is CreateEventPost of API v2: it answers 201 with the new post in the Location header.
+func (eph *EventsPostsHandler) CreateEventPostV2(w http.ResponseWriter, r *http.Request) {
+	eventsData, ok := eph.createEventPost(w, r)
+	if !ok {
+		return
+	}
+
+	utils.WriteCreated(w, r, r.URL.Path+"/"+strconv.FormatUint(eventsData.ID, 10), eventsData)
+}
+
+// createEventPost creates the post from the request body. On failure it writes the error and returns false.
+func (eph *EventsPostsHandler) createEventPost(w http.ResponseWriter, r *http.Request) (*models.EventPost, bool) {
 	vars := mux.Vars(r)
 	eventID, _ := strconv.ParseUint(vars["event_id"], 10, 64)
 	defer r.Body.Close()
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
-		return
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+		return nil, false
 	}
 
 	event := &models.CreatePostRequest{}
 	err := json.NewDecoder(r.Body).Decode(&event)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't unmarshal data"}))
-		return
+		utils.WriteError(w, r, apperrors.Validation("can't unmarshal data"))
+		return nil, false
+	}
+
+	err = event.Validate()
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
 	eventsData := &models.EventPost{
@@ -69,27 +169,17 @@ func (eph *EventsPostsHandler) CreateEventPost(w http.ResponseWriter, r *http.Re
 		User: models.UserCard{
 			VKID: userID,
 		},
-		EventID: eventID,
+		EventID:     eventID,
+		Attachments: []string{},
 	}
 
 	err = eph.eventsUcase.CreateEventPost(eventsData)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
-	body, err := json.Marshal(eventsData)
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-
-	w.WriteHeader(http.StatusOK)
-	w.Write(body)
+	return eventsData, true
 }
 
 // GetEventsPostsByEventID godoc
@@ -99,10 +189,9 @@ func (eph *EventsPostsHandler) CreateEventPost(w http.ResponseWriter, r *http.Re
 // @Accept       json
 // @Produce      json
 // @Param        event_id path int64 true "Event ID"
-// @Param        IdGt query integer false "IdGt"
-// @Param        IdLte query integer false "IdLte"
+// @Param        Cursor query string false "Cursor"
 // @Param        Limit query integer false "Limit"
-// @Success      200  {object}  []models.EventPost
+// @Success      200  {object}  pagination.Response{items=[]models.EventPost}
 // @Failure      400  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
@@ -116,25 +205,28 @@ func (eph *EventsPostsHandler) GetEventsPostsByEventID(w http.ResponseWriter, r
 	decoder.IgnoreUnknownKeys(true)
 	err := decoder.Decode(query, r.URL.Query())
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
 		return
 	}
 
-	events, err := eph.eventsUcase.GetEventsPostsByEventID(eventID, query.IdGt, query.IdLte, query.Limit)
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	events, next, err := eph.eventsUcase.GetEventsPostsByEventID(eventID, page)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 	if len(events) == 0 {
 		events = []*models.EventPost{}
 	}
 
-	body, err := json.Marshal(events)
+	body, err := json.Marshal(pagination.NewResponse(events, next))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -152,6 +244,8 @@ func (eph *EventsPostsHandler) GetEventsPostsByEventID(w http.ResponseWriter, r
 // @Param        post_id path int64 true "Post ID"
 // @Success      200  {object}  models.EventPost
 // @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /events_posts/{post_id}/upload [post]
@@ -161,39 +255,28 @@ func (eph *EventsPostsHandler) UploadAttachments(w http.ResponseWriter, r *http.
 	vars := mux.Vars(r)
 	postID, _ := strconv.ParseUint(vars["post_id"], 10, 64)
 
-	_, ok := r.Context().Value("userID").(uint64)
-	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
-		return
-	}
-
 	r.Body = http.MaxBytesReader(w, r.Body, 10*1024*1024)
 	err := r.ParseMultipartForm(10 * 1024 * 1024)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't parse data"}))
+		utils.WriteError(w, r, apperrors.Validation("can't parse data"))
 		return
 	}
 
 	if len(r.MultipartForm.File["file-upload"]) == 0 {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "no photo"}))
+		utils.WriteError(w, r, apperrors.Validation("no photo"))
 		return
 	}
 
 	file := r.MultipartForm.File["file-upload"]
 	event, err := eph.eventsUcase.UploadAttachments(postID, file)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	body, err := json.Marshal(event)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -212,6 +295,7 @@ func (eph *EventsPostsHandler) UploadAttachments(w http.ResponseWriter, r *http.
 // @Success      200
 // @Failure      400  {object}  utils.Error
 // @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /event_posts/{post_id}/delete [post]
@@ -219,30 +303,9 @@ func (eph *EventsPostsHandler) DeletePost(w http.ResponseWriter, r *http.Request
 	vars := mux.Vars(r)
 	postID, _ := strconv.ParseUint(vars["post_id"], 10, 64)
 
-	userID, ok := r.Context().Value("userID").(uint64)
-	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
-		return
-	}
-
-	post, err := eph.eventsUcase.GetEventPostByPostID(postID)
+	err := eph.eventsUcase.DeletePostByID(int64(postID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
-	}
-
-	if post.User.VKID != userID {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "user has inappropriate status"}))
-		return
-	}
-
-	err = eph.eventsUcase.DeletePostByID(int64(postID))
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -269,16 +332,20 @@ func (eph *EventsPostsHandler) ComplainPost(w http.ResponseWriter, r *http.Reque
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	req := &models.ComplaintReq{}
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't unmarshal data"}))
+		utils.WriteError(w, r, apperrors.Validation("can't unmarshal data"))
+		return
+	}
+
+	err = req.Validate()
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -288,8 +355,7 @@ func (eph *EventsPostsHandler) ComplainPost(w http.ResponseWriter, r *http.Reque
 		TargetID: int64(clubID),
 	})
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -1,11 +1,14 @@
 package events_posts
 
-import "github.com/dantedoyl/car-life-api/internal/app/models"
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+)
 
 type IEventsPostsRepository interface {
 	InsertEventPost(event *models.EventPost) error
-	GetEventPostByPostID (postID uint64) (*models.EventPost, error)
-	GetEventsPostsByEventID(eventID uint64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.EventPost, error)
+	GetEventPostByPostID (postID uint64, userID uint64) (*models.EventPost, error)
+	GetEventsPostsByEventID(eventID uint64, page pagination.Page) ([]*models.EventPost, *pagination.Cursor, error)
 	InsertEventPostAttachments(postID uint64, attachments []string) error
 	DeletePostByID(postID int64) error
 	ComplainByID(complaint models.Complaint) error
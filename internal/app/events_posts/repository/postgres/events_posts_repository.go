@@ -3,8 +3,10 @@ package events_posts_repository
 import (
 	"database/sql"
 	"fmt"
+	"github.com/dantedoyl/car-life-api/internal/app/clients/database"
 	"github.com/dantedoyl/car-life-api/internal/app/events_posts"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"github.com/lib/pq"
 	"strconv"
 )
@@ -35,54 +37,54 @@ func (epr *EventsPostsRepository) InsertEventPost(eventPost *models.EventPost) e
 	return nil
 }
 
-func (epr *EventsPostsRepository) GetEventsPostsByEventID(eventID uint64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.EventPost, error) {
+func (epr *EventsPostsRepository) GetEventsPostsByEventID(eventID uint64, page pagination.Page) ([]*models.EventPost, *pagination.Cursor, error) {
 	var eventsPosts []*models.EventPost
 	ind := 2
 	var values []interface{}
 	q := `SELECT ep.id, ep.text, ep.user_id, u.name, u.surname, u.avatar, ep.event_id, ep.created_at, array_agg(COALESCE(epa.url, '')) from events_posts as ep 
     		left join events_posts_attachments as epa on ep.id = epa.post_id
 			left join users as u on u.vk_id = ep.user_id
-			WHERE ep.event_id = $1 `
+			WHERE ep.event_id = $1 AND ep.hidden = false `
 
 	values = append(values, eventID)
 
-	if idGt != nil {
-		q += ` AND ep.id > $` + strconv.Itoa(ind)
-		values = append(values, idGt)
-		ind++
-	}
-
-	if idLte != nil {
-		q += ` AND ep.id <= $` + strconv.Itoa(ind)
-		values = append(values, idLte)
-		ind++
-	}
-
-	if limit != nil {
-		q += ` LIMIT $` + strconv.Itoa(ind)
-		values = append(values, limit)
+	if page.After != nil {
+		createdAt, err := page.After.Time()
+		if err != nil {
+			return nil, nil, err
+		}
+		q += ` AND (ep.created_at, ep.id) < ($` + strconv.Itoa(ind) + `, $` + strconv.Itoa(ind+1) + `)`
+		values = append(values, createdAt, page.After.ID)
+		ind += 2
 	}
 
-	q += ` GROUP BY ep.id, u.name, u.surname, u.avatar ORDER BY ep.created_at desc`
+	q += ` GROUP BY ep.id, u.name, u.surname, u.avatar ORDER BY ep.created_at desc, ep.id desc LIMIT $` + strconv.Itoa(ind)
+	values = append(values, page.FetchLimit())
 	rows, err := epr.dbConn.Query(q, values...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rows.Close()
 
+	var last, next *pagination.Cursor
 	for rows.Next() {
 		post := &models.EventPost{}
 		err = rows.Scan(&post.ID, &post.Text, &post.User.VKID, &post.User.Name, &post.User.Surname, &post.User.AvatarUrl, &post.EventID, &post.CreatedAt, pq.Array(&post.Attachments))
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if post.Attachments[0] == "" {
 			post.Attachments = []string{}
 		}
+		if page.Full(len(eventsPosts)) {
+			next = last
+			break
+		}
 		eventsPosts = append(eventsPosts, post)
+		last = &pagination.Cursor{Key: pagination.TimeKey(post.CreatedAt), ID: post.ID}
 	}
-	return eventsPosts, nil
+	return eventsPosts, next, rows.Err()
 }
 
 func (epr *EventsPostsRepository) InsertEventPostAttachments(postID uint64, attachments []string) error {
@@ -107,14 +109,17 @@ func (epr *EventsPostsRepository) InsertEventPostAttachments(postID uint64, atta
 	return nil
 }
 
-func (epr *EventsPostsRepository) GetEventPostByPostID(postID uint64) (*models.EventPost, error) {
+func (epr *EventsPostsRepository) GetEventPostByPostID(postID uint64, userID uint64) (*models.EventPost, error) {
 	post := &models.EventPost{}
 	err := epr.dbConn.QueryRow(
 		`SELECT ep.id, ep.text, ep.user_id, u.name, u.surname, u.avatar, ep.event_id, ep.created_at, array_agg(COALESCE(epa.url, '')) from events_posts as ep 
     			left join events_posts_attachments as epa on ep.id = epa.post_id
 				left join users as u on u.vk_id = ep.user_id
-				WHERE ep.id = $1 
-				GROUP BY ep.id, u.name, u.surname, u.avatar `, postID).Scan(&post.ID, &post.Text, &post.User.VKID, &post.User.Name, &post.User.Surname, &post.User.AvatarUrl, &post.EventID, &post.CreatedAt, pq.Array(&post.Attachments))
+				WHERE ep.id = $1 and `+database.Visible("ep", 2)+`
+				GROUP BY ep.id, u.name, u.surname, u.avatar `, postID, userID).Scan(&post.ID, &post.Text, &post.User.VKID, &post.User.Name, &post.User.Surname, &post.User.AvatarUrl, &post.EventID, &post.CreatedAt, pq.Array(&post.Attachments))
+	if err == sql.ErrNoRows {
+		return nil, events_posts.ErrPostNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1,15 +1,21 @@
 package events_posts
 
 import (
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"mime/multipart"
 )
 
+var (
+	ErrPostNotFound = apperrors.NotFound("post not found")
+)
+
 type IEventsPostsUsecase interface {
 	CreateEventPost(event *models.EventPost) error
-	GetEventsPostsByEventID(eventID uint64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.EventPost, error)
+	GetEventsPostsByEventID(eventID uint64, page pagination.Page) ([]*models.EventPost, *pagination.Cursor, error)
 	UploadAttachments(postID uint64, fileHeader []*multipart.FileHeader) (*models.EventPost, error)
-	GetEventPostByPostID (postID uint64) (*models.EventPost, error)
+	GetEventPostByPostID (postID uint64, userID uint64) (*models.EventPost, error)
 	DeletePostByID(postID int64) error
 	ComplainByID(complaint models.Complaint) error
 }
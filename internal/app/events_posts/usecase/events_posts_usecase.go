@@ -4,6 +4,7 @@ import (
 	"github.com/dantedoyl/car-life-api/internal/app/clients/filesystem"
 	"github.com/dantedoyl/car-life-api/internal/app/events_posts"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"mime/multipart"
 )
 
@@ -21,12 +22,12 @@ func (epu *EventsPostsUsecase) CreateEventPost(eventPost *models.EventPost) erro
 	return epu.eventsPostsRepo.InsertEventPost(eventPost)
 }
 
-func (epu *EventsPostsUsecase) GetEventsPostsByEventID(eventID uint64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.EventPost, error) {
-	return epu.eventsPostsRepo.GetEventsPostsByEventID(eventID, idGt, idLte, limit)
+func (epu *EventsPostsUsecase) GetEventsPostsByEventID(eventID uint64, page pagination.Page) ([]*models.EventPost, *pagination.Cursor, error) {
+	return epu.eventsPostsRepo.GetEventsPostsByEventID(eventID, page)
 }
 
 func (epu *EventsPostsUsecase) UploadAttachments(postID uint64, fileHeader []*multipart.FileHeader) (*models.EventPost, error) {
-	event, err := epu.eventsPostsRepo.GetEventPostByPostID(postID)
+	event, err := epu.eventsPostsRepo.GetEventPostByPostID(postID, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -46,8 +47,8 @@ func (epu *EventsPostsUsecase) UploadAttachments(postID uint64, fileHeader []*mu
 	return event, nil
 }
 
-func (epu *EventsPostsUsecase) GetEventPostByPostID (postID uint64) (*models.EventPost, error) {
-	return epu.eventsPostsRepo.GetEventPostByPostID(postID)
+func (epu *EventsPostsUsecase) GetEventPostByPostID (postID uint64, userID uint64) (*models.EventPost, error) {
+	return epu.eventsPostsRepo.GetEventPostByPostID(postID, userID)
 }
 
 func (epu *EventsPostsUsecase) DeletePostByID (postID int64) error {
@@ -0,0 +1,202 @@
+package graphql
+
+import (
+	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+	clubs "github.com/dantedoyl/car-life-api/internal/app/clubs"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// clubResolver resolves a club by id through the clubs loader, so that the clubs of a page
+// are loaded with one query whatever fields are asked for.
+type clubResolver struct {
+	root *resolver
+	id   uint64
+}
+
+func (c *clubResolver) load(ctx context.Context) (*models.Club, error) {
+	club, err := requestFrom(ctx).clubs.Load(c.id)
+	if err != nil {
+		return nil, err
+	}
+	if club == nil {
+		return nil, clubs.ErrClubNotFound
+	}
+	return club.(*models.Club), nil
+}
+
+func (c *clubResolver) ID() gql.ID {
+	return toID(c.id)
+}
+
+func (c *clubResolver) Name(ctx context.Context) (string, error) {
+	club, err := c.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return club.Name, nil
+}
+
+func (c *clubResolver) Description(ctx context.Context) (string, error) {
+	club, err := c.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return club.Description, nil
+}
+
+func (c *clubResolver) AvatarUrl(ctx context.Context) (string, error) {
+	club, err := c.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return club.AvatarUrl, nil
+}
+
+func (c *clubResolver) Tags(ctx context.Context) ([]string, error) {
+	club, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if club.Tags == nil {
+		return []string{}, nil
+	}
+	return club.Tags, nil
+}
+
+func (c *clubResolver) EventsCount(ctx context.Context) (int32, error) {
+	club, err := c.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int32(club.EventsCount), nil
+}
+
+func (c *clubResolver) ParticipantsCount(ctx context.Context) (int32, error) {
+	club, err := c.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int32(club.ParticipantsCount), nil
+}
+
+func (c *clubResolver) SubscribersCount(ctx context.Context) (int32, error) {
+	club, err := c.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int32(club.SubscribersCount), nil
+}
+
+func (c *clubResolver) Owner(ctx context.Context) (*userResolver, error) {
+	club, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newUserCardResolver(c.root, club.Owner), nil
+}
+
+func (c *clubResolver) UserStatus(ctx context.Context) (*string, error) {
+	if !requestFrom(ctx).authed {
+		return nil, nil
+	}
+	club, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &club.UserStatus, nil
+}
+
+func (c *clubResolver) UpdatedAt(ctx context.Context) (gql.Time, error) {
+	club, err := c.load(ctx)
+	if err != nil {
+		return gql.Time{}, err
+	}
+	return gql.Time{Time: club.UpdatedAt}, nil
+}
+
+func (c *clubResolver) Events(args pageArgs) (*eventConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	cards, next, err := c.root.clubsUcase.GetClubsEvents(int64(c.id), page)
+	if err != nil {
+		return nil, err
+	}
+	conn := &eventConnection{connection: connection{next: next}}
+	for _, card := range cards {
+		conn.items = append(conn.items, &eventResolver{root: c.root, id: card.ID})
+	}
+	return conn, nil
+}
+
+func (c *clubResolver) Cars(args pageArgs) (*carConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	cars, next, err := c.root.clubsUcase.GetClubsCars(int64(c.id), page)
+	if err != nil {
+		return nil, err
+	}
+	return newCarConnection(c.root, cars, next), nil
+}
+
+func (c *clubResolver) Members(ctx context.Context, args statusArgs) (*userConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	status := args.status()
+	if status == "participant_request" {
+		err = c.root.can(ctx, authorization.ActionClubViewRequests, c.id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	cards, next, err := c.root.clubsUcase.GetClubsUserByStatus(int64(c.id), status, page)
+	if err != nil {
+		return nil, err
+	}
+	return newUserConnection(c.root, cards, next), nil
+}
+
+func (c *clubResolver) ChatLink(ctx context.Context) (*string, error) {
+	err := c.root.can(ctx, authorization.ActionClubViewChat, c.id)
+	if err != nil {
+		return nil, err
+	}
+	chatID, err := c.root.clubsUcase.GetClubChatID(int64(c.id), int64(requestFrom(ctx).viewerID))
+	if err != nil {
+		return nil, err
+	}
+	if chatID == 0 {
+		return nil, apperrors.Validation("no chat for this club")
+	}
+	link, err := c.root.vk.GetChatLink(ctx, int(chatID))
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// can is RequirePermission for a field: the viewer must be allowed the action on the resource.
+func (rv *resolver) can(ctx context.Context, action authorization.Action, resourceID uint64) error {
+	viewerID, err := viewer(ctx)
+	if err != nil {
+		return err
+	}
+	return rv.authUcase.Can(viewerID, action, resourceID)
+}
+
+type clubConnection struct {
+	connection
+	items []*clubResolver
+}
+
+func (c *clubConnection) Items() []*clubResolver {
+	return c.items
+}
@@ -0,0 +1,297 @@
+package graphql
+
+import (
+	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+	"github.com/dantedoyl/car-life-api/internal/app/events"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// eventResolver resolves an event by id through the events loader, like clubResolver does clubs.
+type eventResolver struct {
+	root *resolver
+	id   uint64
+}
+
+func (e *eventResolver) load(ctx context.Context) (*models.Event, error) {
+	event, err := requestFrom(ctx).events.Load(e.id)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, events.ErrEventNotFound
+	}
+	return event.(*models.Event), nil
+}
+
+func (e *eventResolver) ID() gql.ID {
+	return toID(e.id)
+}
+
+func (e *eventResolver) Name(ctx context.Context) (string, error) {
+	event, err := e.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return event.Name, nil
+}
+
+// Club goes through the clubs loader, the event only carries a part of its club.
+func (e *eventResolver) Club(ctx context.Context) (*clubResolver, error) {
+	event, err := e.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &clubResolver{root: e.root, id: event.Club.ID}, nil
+}
+
+func (e *eventResolver) Creator(ctx context.Context) (*userResolver, error) {
+	event, err := e.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newUserCardResolver(e.root, event.Creator), nil
+}
+
+func (e *eventResolver) Description(ctx context.Context) (string, error) {
+	event, err := e.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return event.Description, nil
+}
+
+func (e *eventResolver) EventDate(ctx context.Context) (gql.Time, error) {
+	event, err := e.load(ctx)
+	if err != nil {
+		return gql.Time{}, err
+	}
+	return gql.Time{Time: event.EventDate}, nil
+}
+
+func (e *eventResolver) Latitude(ctx context.Context) (float64, error) {
+	event, err := e.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return float64(event.Latitude), nil
+}
+
+func (e *eventResolver) Longitude(ctx context.Context) (float64, error) {
+	event, err := e.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return float64(event.Longitude), nil
+}
+
+func (e *eventResolver) AvatarUrl(ctx context.Context) (string, error) {
+	event, err := e.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return event.AvatarUrl, nil
+}
+
+func (e *eventResolver) ParticipantsCount(ctx context.Context) (int32, error) {
+	event, err := e.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int32(event.ParticipantsCount), nil
+}
+
+func (e *eventResolver) SpectatorsCount(ctx context.Context) (int32, error) {
+	event, err := e.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int32(event.SpectatorsCount), nil
+}
+
+func (e *eventResolver) UserStatus(ctx context.Context) (*string, error) {
+	if !requestFrom(ctx).authed {
+		return nil, nil
+	}
+	event, err := e.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &event.UserStatus, nil
+}
+
+func (e *eventResolver) UpdatedAt(ctx context.Context) (gql.Time, error) {
+	event, err := e.load(ctx)
+	if err != nil {
+		return gql.Time{}, err
+	}
+	return gql.Time{Time: event.UpdatedAt}, nil
+}
+
+func (e *eventResolver) Members(ctx context.Context, args statusArgs) (*userConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	status := args.status()
+	if status == "participant_request" {
+		err = e.root.can(ctx, authorization.ActionEventViewRequests, e.id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	cards, next, err := e.root.eventsUcase.GetEventsUserByStatus(int64(e.id), status, page)
+	if err != nil {
+		return nil, err
+	}
+	return newUserConnection(e.root, cards, next), nil
+}
+
+func (e *eventResolver) Posts(args pageArgs) (*eventPostConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	posts, next, err := e.root.eventsPostsUcase.GetEventsPostsByEventID(e.id, page)
+	if err != nil {
+		return nil, err
+	}
+	conn := &eventPostConnection{connection: connection{next: next}, items: make([]*eventPostResolver, 0, len(posts))}
+	for _, post := range posts {
+		conn.items = append(conn.items, &eventPostResolver{root: e.root, post: post})
+	}
+	return conn, nil
+}
+
+func (e *eventResolver) ChatLink(ctx context.Context) (*string, error) {
+	err := e.root.can(ctx, authorization.ActionEventViewChat, e.id)
+	if err != nil {
+		return nil, err
+	}
+	chatID, err := e.root.eventsUcase.GetEventChatID(int64(e.id), int64(requestFrom(ctx).viewerID))
+	if err != nil {
+		return nil, err
+	}
+	if chatID == 0 {
+		return nil, apperrors.Validation("no chat for this event")
+	}
+	link, err := e.root.vk.GetChatLink(ctx, int(chatID))
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+type eventConnection struct {
+	connection
+	items []*eventResolver
+}
+
+func (c *eventConnection) Items() []*eventResolver {
+	return c.items
+}
+
+type eventPostResolver struct {
+	root *resolver
+	post *models.EventPost
+}
+
+func (p *eventPostResolver) ID() gql.ID {
+	return toID(p.post.ID)
+}
+
+func (p *eventPostResolver) Text() string {
+	return p.post.Text
+}
+
+func (p *eventPostResolver) Author() *userResolver {
+	return newUserCardResolver(p.root, p.post.User)
+}
+
+func (p *eventPostResolver) Event() *eventResolver {
+	return &eventResolver{root: p.root, id: p.post.EventID}
+}
+
+func (p *eventPostResolver) CreatedAt() gql.Time {
+	return gql.Time{Time: p.post.CreatedAt}
+}
+
+func (p *eventPostResolver) Attachments() []string {
+	if p.post.Attachments == nil {
+		return []string{}
+	}
+	return p.post.Attachments
+}
+
+type eventPostConnection struct {
+	connection
+	items []*eventPostResolver
+}
+
+func (c *eventPostConnection) Items() []*eventPostResolver {
+	return c.items
+}
+
+type miniEventResolver struct {
+	root      *resolver
+	miniEvent *models.MiniEvent
+}
+
+func (m *miniEventResolver) ID() gql.ID {
+	return toID(m.miniEvent.ID)
+}
+
+func (m *miniEventResolver) Type() *miniEventTypeResolver {
+	return &miniEventTypeResolver{miniEventType: &m.miniEvent.Type}
+}
+
+func (m *miniEventResolver) Author() *userResolver {
+	return newUserCardResolver(m.root, m.miniEvent.User)
+}
+
+func (m *miniEventResolver) Description() string {
+	return m.miniEvent.Description
+}
+
+func (m *miniEventResolver) CreatedAt() gql.Time {
+	return gql.Time{Time: m.miniEvent.CreatedAt}
+}
+
+func (m *miniEventResolver) EndedAt() gql.Time {
+	return gql.Time{Time: m.miniEvent.EndedAt}
+}
+
+func (m *miniEventResolver) Latitude() float64 {
+	return float64(m.miniEvent.Latitude)
+}
+
+func (m *miniEventResolver) Longitude() float64 {
+	return float64(m.miniEvent.Longitude)
+}
+
+type miniEventTypeResolver struct {
+	miniEventType *models.MiniEventType
+}
+
+func (t *miniEventTypeResolver) ID() gql.ID {
+	return toID(t.miniEventType.ID)
+}
+
+func (t *miniEventTypeResolver) Name() string {
+	return t.miniEventType.PublicName
+}
+
+func (t *miniEventTypeResolver) Description() string {
+	return t.miniEventType.PublicDescription
+}
+
+type miniEventConnection struct {
+	connection
+	items []*miniEventResolver
+}
+
+func (c *miniEventConnection) Items() []*miniEventResolver {
+	return c.items
+}
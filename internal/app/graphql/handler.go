@@ -0,0 +1,129 @@
+// Package graphql serves the entities of the API and their relations as one GraphQL schema, so that
+// a screen that needs a club, its events, cars and members asks for them with a single request.
+// The resolvers only call the usecases the REST handlers do and mirror their permission checks.
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+	"github.com/dantedoyl/car-life-api/internal/app/clients/vk"
+	clubs "github.com/dantedoyl/car-life-api/internal/app/clubs"
+	"github.com/dantedoyl/car-life-api/internal/app/events"
+	"github.com/dantedoyl/car-life-api/internal/app/events_posts"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"github.com/dantedoyl/car-life-api/internal/app/middleware"
+	"github.com/dantedoyl/car-life-api/internal/app/mini_events"
+	"github.com/dantedoyl/car-life-api/internal/app/users"
+	"github.com/dantedoyl/car-life-api/internal/app/utils"
+	"github.com/gorilla/mux"
+	gql "github.com/graph-gophers/graphql-go"
+	"net/http"
+)
+
+//go:embed schema.graphql
+var schemaString string
+
+const (
+	// maxDepth bounds the nesting of a query, each level can cost a query per page.
+	maxDepth = 10
+	// maxBodySize bounds the size of a query document with its variables.
+	maxBodySize = 1 << 20
+)
+
+type Handler struct {
+	resolver *resolver
+	schema   *gql.Schema
+}
+
+func NewHandler(usersUcase users.IUsersUsecase, clubsUcase clubs.IClubsUsecase, eventsUcase events.IEventsUsecase,
+	eventsPostsUcase events_posts.IEventsPostsUsecase, miniEventsUcase mini_events.IMiniEventsUsecase,
+	authUcase authorization.IAuthorizationUsecase, vkCl vk.Client) *Handler {
+	rv := &resolver{
+		usersUcase:       usersUcase,
+		clubsUcase:       clubsUcase,
+		eventsUcase:      eventsUcase,
+		eventsPostsUcase: eventsPostsUcase,
+		miniEventsUcase:  miniEventsUcase,
+		authUcase:        authUcase,
+		vk:               vkCl,
+	}
+
+	return &Handler{
+		resolver: rv,
+		// The items of a list are resolved concurrently up to MaxParallelism at a time,
+		// which lets the loaders batch a whole page.
+		schema: gql.MustParseSchema(schemaString, rv,
+			gql.MaxDepth(maxDepth),
+			gql.MaxParallelism(maxBatch),
+			gql.Logger(panicLogger{}),
+		),
+	}
+}
+
+func (h *Handler) Configure(r *mux.Router, mw *middleware.Middleware) {
+	r.HandleFunc("/graphql", mw.CheckAuthMiddleware(h.Query)).Methods(http.MethodPost, http.MethodOptions)
+}
+
+type queryRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Query answers 200 with the data and the errors of the query like any GraphQL server; only a body
+// that isn't a query at all gets the API error. Every resolver error carries the apperrors code
+// in its extensions, and internal errors are logged and hidden the way utils.WriteError does.
+func (h *Handler) Query(w http.ResponseWriter, r *http.Request) {
+	req := &queryRequest{}
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(req)
+	if err != nil {
+		utils.WriteError(w, r, apperrors.Validation("body is not a GraphQL request"))
+		return
+	}
+	if req.Query == "" {
+		utils.WriteError(w, r, apperrors.InvalidFields([]apperrors.FieldError{{Field: "query", Error: "is required"}}))
+		return
+	}
+
+	ctx := h.resolver.newRequest(r.Context())
+	resp := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+
+	for _, qErr := range resp.Errors {
+		if qErr.ResolverError == nil {
+			continue
+		}
+
+		var appErr *apperrors.Error
+		if !errors.As(qErr.ResolverError, &appErr) {
+			logger.FromContext(r.Context()).Error("graphql resolver failed", "error", qErr.ResolverError, "path", qErr.Path)
+			appErr = &apperrors.Error{Code: apperrors.CodeInternal, Message: "internal server error"}
+		}
+
+		qErr.Message = appErr.Error()
+		qErr.Extensions = map[string]interface{}{"code": appErr.Code}
+		if len(appErr.Fields) != 0 {
+			qErr.Extensions["fields"] = appErr.Fields
+		}
+	}
+
+	body, err := json.Marshal(resp)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// panicLogger logs the panics of the resolvers, which the schema turns into errors, with the request logger.
+type panicLogger struct{}
+
+func (panicLogger) LogPanic(ctx context.Context, value interface{}) {
+	logger.FromContext(ctx).Error("graphql resolver panicked", "panic", value)
+}
@@ -0,0 +1,117 @@
+package graphql
+
+import (
+	"sync"
+	"time"
+)
+
+// BatchFunc loads the values of keys with one call and returns them by key.
+// A key missing from the result has no value, which Load reports as nil.
+type BatchFunc func(keys []uint64) (map[uint64]interface{}, error)
+
+// Loader coalesces the loads of one kind made while a query is resolved. The resolvers of list items
+// run concurrently, so every key asked for within wait of the first one goes into the same batch,
+// and club owners of a page of clubs cost one query instead of one per club.
+// The values are cached for the life of the loader, which is one request.
+type Loader struct {
+	fetch    BatchFunc
+	wait     time.Duration
+	maxBatch int
+
+	mtx   sync.Mutex
+	cache map[uint64]*loadResult
+	batch *loadBatch
+}
+
+type loadResult struct {
+	value interface{}
+	err   error
+	done  chan struct{}
+}
+
+type loadBatch struct {
+	keys    []uint64
+	results []*loadResult
+}
+
+func NewLoader(fetch BatchFunc, wait time.Duration, maxBatch int) *Loader {
+	return &Loader{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		cache:    make(map[uint64]*loadResult),
+	}
+}
+
+// Load returns the value of key, waiting for the batch it joins to be fetched.
+func (l *Loader) Load(key uint64) (interface{}, error) {
+	l.mtx.Lock()
+	res, ok := l.cache[key]
+	if !ok {
+		res = &loadResult{done: make(chan struct{})}
+		l.cache[key] = res
+		l.enqueue(key, res)
+	}
+	l.mtx.Unlock()
+
+	<-res.done
+	return res.value, res.err
+}
+
+// Prime caches a value that was loaded some other way, so that loading it again costs nothing.
+func (l *Loader) Prime(key uint64, value interface{}) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if _, ok := l.cache[key]; ok {
+		return
+	}
+	res := &loadResult{value: value, done: make(chan struct{})}
+	close(res.done)
+	l.cache[key] = res
+}
+
+// enqueue must be called with mtx held.
+func (l *Loader) enqueue(key uint64, res *loadResult) {
+	if l.batch == nil {
+		l.batch = &loadBatch{}
+		go l.dispatchAfterWait(l.batch)
+	}
+
+	l.batch.keys = append(l.batch.keys, key)
+	l.batch.results = append(l.batch.results, res)
+
+	if len(l.batch.keys) >= l.maxBatch {
+		b := l.batch
+		l.batch = nil
+		go l.run(b)
+	}
+}
+
+func (l *Loader) dispatchAfterWait(b *loadBatch) {
+	time.Sleep(l.wait)
+
+	l.mtx.Lock()
+	if l.batch != b {
+		// The batch filled up and was dispatched already.
+		l.mtx.Unlock()
+		return
+	}
+	l.batch = nil
+	l.mtx.Unlock()
+
+	l.run(b)
+}
+
+func (l *Loader) run(b *loadBatch) {
+	values, err := l.fetch(b.keys)
+	for i, key := range b.keys {
+		res := b.results[i]
+		if err != nil {
+			res.err = err
+		} else {
+			res.value = values[key]
+		}
+		close(res.done)
+	}
+}
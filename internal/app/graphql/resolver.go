@@ -0,0 +1,319 @@
+package graphql
+
+import (
+	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+	"github.com/dantedoyl/car-life-api/internal/app/clients/vk"
+	clubs "github.com/dantedoyl/car-life-api/internal/app/clubs"
+	"github.com/dantedoyl/car-life-api/internal/app/events"
+	"github.com/dantedoyl/car-life-api/internal/app/events_posts"
+	"github.com/dantedoyl/car-life-api/internal/app/mini_events"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+	"github.com/dantedoyl/car-life-api/internal/app/users"
+	gql "github.com/graph-gophers/graphql-go"
+	"strconv"
+	"time"
+)
+
+const (
+	// loadWait is how long a loader waits for more keys before it fetches a batch.
+	loadWait = time.Millisecond
+	// maxBatch is the most keys a loader fetches at once. A page of a connection fits in one batch.
+	maxBatch = pagination.MaxLimit
+)
+
+// resolver is the Query type of the schema. It holds nothing specific to a request,
+// the viewer and the loaders are in the request context.
+type resolver struct {
+	usersUcase       users.IUsersUsecase
+	clubsUcase       clubs.IClubsUsecase
+	eventsUcase      events.IEventsUsecase
+	eventsPostsUcase events_posts.IEventsPostsUsecase
+	miniEventsUcase  mini_events.IMiniEventsUsecase
+	authUcase        authorization.IAuthorizationUsecase
+	vk               vk.Client
+}
+
+type requestKey struct{}
+
+// request is the state of one query: the user of the session, if any, and the loaders that
+// batch and cache the entities the query reaches.
+type request struct {
+	viewerID uint64
+	authed   bool
+	users    *Loader
+	clubs    *Loader
+	events   *Loader
+}
+
+func (rv *resolver) newRequest(ctx context.Context) context.Context {
+	req := &request{}
+	req.viewerID, req.authed = ctx.Value("userID").(uint64)
+
+	req.users = NewLoader(func(ids []uint64) (map[uint64]interface{}, error) {
+		found, err := rv.usersUcase.GetByIDs(ids)
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[uint64]interface{}, len(found))
+		for _, user := range found {
+			byID[user.VKID] = user
+		}
+		return byID, nil
+	}, loadWait, maxBatch)
+
+	req.clubs = NewLoader(func(ids []uint64) (map[uint64]interface{}, error) {
+		found, err := rv.clubsUcase.GetClubsByIDs(ids, req.viewerID)
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[uint64]interface{}, len(found))
+		for _, club := range found {
+			byID[club.ID] = club
+		}
+		return byID, nil
+	}, loadWait, maxBatch)
+
+	req.events = NewLoader(func(ids []uint64) (map[uint64]interface{}, error) {
+		found, err := rv.eventsUcase.GetEventsByIDs(ids, req.viewerID)
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[uint64]interface{}, len(found))
+		for _, event := range found {
+			byID[event.ID] = event
+		}
+		return byID, nil
+	}, loadWait, maxBatch)
+
+	return context.WithValue(ctx, requestKey{}, req)
+}
+
+func requestFrom(ctx context.Context) *request {
+	return ctx.Value(requestKey{}).(*request)
+}
+
+// viewer returns the id of the user of the session, the way the v1 handlers refuse anonymous requests otherwise.
+func viewer(ctx context.Context) (uint64, error) {
+	req := requestFrom(ctx)
+	if !req.authed {
+		return 0, apperrors.Unauthorized("you're unauthorized")
+	}
+	return req.viewerID, nil
+}
+
+func parseID(id gql.ID) (uint64, error) {
+	parsed, err := strconv.ParseUint(string(id), 10, 64)
+	if err != nil {
+		return 0, apperrors.Validation("invalid id")
+	}
+	return parsed, nil
+}
+
+func toID(id uint64) gql.ID {
+	return gql.ID(strconv.FormatUint(id, 10))
+}
+
+type pageArgs struct {
+	First *int32
+	After *string
+}
+
+type searchArgs struct {
+	Query *string
+	pageArgs
+}
+
+// page checks first here rather than in pagination.NewPage, so that the error names the argument.
+func (a pageArgs) page() (pagination.Page, error) {
+	var limit *uint64
+	if a.First != nil {
+		if *a.First < 1 || *a.First > pagination.MaxLimit {
+			return pagination.Page{}, apperrors.InvalidFields([]apperrors.FieldError{{
+				Field: "first",
+				Error: "must be between 1 and " + strconv.Itoa(pagination.MaxLimit),
+			}})
+		}
+		first := uint64(*a.First)
+		limit = &first
+	}
+	return pagination.NewPage(a.After, limit)
+}
+
+func (rv *resolver) Me(ctx context.Context) (*userResolver, error) {
+	viewerID, err := viewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rv.user(ctx, viewerID)
+}
+
+func (rv *resolver) User(ctx context.Context, args struct{ ID gql.ID }) (*userResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	return rv.user(ctx, id)
+}
+
+func (rv *resolver) user(ctx context.Context, id uint64) (*userResolver, error) {
+	user, err := rv.usersUcase.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	requestFrom(ctx).users.Prime(id, user)
+	return &userResolver{root: rv, id: id}, nil
+}
+
+func (rv *resolver) Car(ctx context.Context, args struct{ ID gql.ID }) (*carResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	car, err := rv.usersUcase.SelectCarByID(int64(id), requestFrom(ctx).viewerID)
+	if err != nil {
+		return nil, err
+	}
+	return &carResolver{root: rv, car: car}, nil
+}
+
+func (rv *resolver) Club(ctx context.Context, args struct{ ID gql.ID }) (*clubResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	club, err := rv.clubsUcase.GetClubByID(id, requestFrom(ctx).viewerID)
+	if err != nil {
+		return nil, err
+	}
+	requestFrom(ctx).clubs.Prime(id, club)
+	return &clubResolver{root: rv, id: id}, nil
+}
+
+func (rv *resolver) Clubs(args searchArgs) (*clubConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	found, next, err := rv.clubsUcase.GetClubs(page, args.Query)
+	if err != nil {
+		return nil, err
+	}
+	conn := &clubConnection{connection: connection{next: next}}
+	for _, club := range found {
+		conn.items = append(conn.items, &clubResolver{root: rv, id: club.ID})
+	}
+	return conn, nil
+}
+
+func (rv *resolver) Tags() ([]*tagResolver, error) {
+	tags, err := rv.clubsUcase.GetTags()
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*tagResolver, 0, len(tags))
+	for i := range tags {
+		resolvers = append(resolvers, &tagResolver{tag: &tags[i]})
+	}
+	return resolvers, nil
+}
+
+func (rv *resolver) Event(ctx context.Context, args struct{ ID gql.ID }) (*eventResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	event, err := rv.eventsUcase.GetEventByID(id, requestFrom(ctx).viewerID)
+	if err != nil {
+		return nil, err
+	}
+	requestFrom(ctx).events.Prime(id, event)
+	return &eventResolver{root: rv, id: id}, nil
+}
+
+func (rv *resolver) Events(args searchArgs) (*eventConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	found, next, err := rv.eventsUcase.GetEvents(page, args.Query, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	conn := &eventConnection{connection: connection{next: next}}
+	for _, event := range found {
+		conn.items = append(conn.items, &eventResolver{root: rv, id: event.ID})
+	}
+	return conn, nil
+}
+
+func (rv *resolver) EventPost(ctx context.Context, args struct{ ID gql.ID }) (*eventPostResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	post, err := rv.eventsPostsUcase.GetEventPostByPostID(id, requestFrom(ctx).viewerID)
+	if err != nil {
+		return nil, err
+	}
+	return &eventPostResolver{root: rv, post: post}, nil
+}
+
+func (rv *resolver) MiniEvent(args struct{ ID gql.ID }) (*miniEventResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	miniEvent, err := rv.miniEventsUcase.GetMiniEventByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &miniEventResolver{root: rv, miniEvent: miniEvent}, nil
+}
+
+func (rv *resolver) MiniEvents(args searchArgs) (*miniEventConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	found, next, err := rv.miniEventsUcase.GetMiniEvents(page, args.Query)
+	if err != nil {
+		return nil, err
+	}
+	conn := &miniEventConnection{connection: connection{next: next}}
+	for _, miniEvent := range found {
+		conn.items = append(conn.items, &miniEventResolver{root: rv, miniEvent: miniEvent})
+	}
+	return conn, nil
+}
+
+// connection is what every connection type has besides its items: the pagination.Response fields.
+type connection struct {
+	next *pagination.Cursor
+}
+
+func (c connection) NextCursor() *string {
+	if c.next == nil {
+		return nil
+	}
+	cursor := c.next.Encode()
+	return &cursor
+}
+
+func (c connection) HasMore() bool {
+	return c.next != nil
+}
+
+type tagResolver struct {
+	tag *models.Tag
+}
+
+func (t *tagResolver) ID() gql.ID {
+	return toID(t.tag.ID)
+}
+
+func (t *tagResolver) Name() string {
+	return t.tag.Name
+}
@@ -0,0 +1,235 @@
+package graphql
+
+import (
+	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+	"github.com/dantedoyl/car-life-api/internal/app/users"
+	gql "github.com/graph-gophers/graphql-go"
+	"strings"
+)
+
+// userResolver resolves a user by id through the users loader. The lists of members come with
+// a card of every user, which answers the name and the avatar without loading the user.
+type userResolver struct {
+	root *resolver
+	id   uint64
+	card *models.UserCard
+}
+
+func newUserCardResolver(root *resolver, card models.UserCard) *userResolver {
+	return &userResolver{root: root, id: card.VKID, card: &card}
+}
+
+func (u *userResolver) load(ctx context.Context) (*models.User, error) {
+	user, err := requestFrom(ctx).users.Load(u.id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, users.ErrUserNotFound
+	}
+	return user.(*models.User), nil
+}
+
+func (u *userResolver) ID() gql.ID {
+	return toID(u.id)
+}
+
+func (u *userResolver) Name(ctx context.Context) (string, error) {
+	if u.card != nil {
+		return u.card.Name, nil
+	}
+	user, err := u.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return user.Name, nil
+}
+
+func (u *userResolver) Surname(ctx context.Context) (string, error) {
+	if u.card != nil {
+		return u.card.Surname, nil
+	}
+	user, err := u.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return user.Surname, nil
+}
+
+func (u *userResolver) AvatarUrl(ctx context.Context) (string, error) {
+	if u.card != nil {
+		return u.card.AvatarUrl, nil
+	}
+	user, err := u.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return user.AvatarUrl, nil
+}
+
+func (u *userResolver) Tags(ctx context.Context) ([]string, error) {
+	user, err := u.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.Tags == nil {
+		return []string{}, nil
+	}
+	return user.Tags, nil
+}
+
+func (u *userResolver) Description(ctx context.Context) (string, error) {
+	user, err := u.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return user.Description, nil
+}
+
+func (u *userResolver) UpdatedAt(ctx context.Context) (gql.Time, error) {
+	user, err := u.load(ctx)
+	if err != nil {
+		return gql.Time{}, err
+	}
+	return gql.Time{Time: user.UpdatedAt}, nil
+}
+
+func (u *userResolver) Cars(args pageArgs) (*carConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	cars, next, err := u.root.usersUcase.SelectCarByUserID(int64(u.id), page)
+	if err != nil {
+		return nil, err
+	}
+	return newCarConnection(u.root, cars, next), nil
+}
+
+type statusArgs struct {
+	Status string
+	pageArgs
+}
+
+// status turns a status enum value into the status the usecases take: PARTICIPANT_REQUEST is participant_request.
+func (a statusArgs) status() string {
+	return strings.ToLower(a.Status)
+}
+
+func (u *userResolver) Clubs(args statusArgs) (*clubConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	cards, next, err := u.root.usersUcase.GetClubsByUserStatus(int64(u.id), args.status(), page)
+	if err != nil {
+		return nil, err
+	}
+	conn := &clubConnection{connection: connection{next: next}}
+	for _, card := range cards {
+		conn.items = append(conn.items, &clubResolver{root: u.root, id: card.ID})
+	}
+	return conn, nil
+}
+
+func (u *userResolver) Events(args statusArgs) (*eventConnection, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	cards, next, err := u.root.usersUcase.GetEventsByUserStatus(int64(u.id), args.status(), page)
+	if err != nil {
+		return nil, err
+	}
+	conn := &eventConnection{connection: connection{next: next}}
+	for _, card := range cards {
+		conn.items = append(conn.items, &eventResolver{root: u.root, id: card.ID})
+	}
+	return conn, nil
+}
+
+type userConnection struct {
+	connection
+	items []*userResolver
+}
+
+func (c *userConnection) Items() []*userResolver {
+	return c.items
+}
+
+func newUserConnection(root *resolver, cards []*models.UserCard, next *pagination.Cursor) *userConnection {
+	conn := &userConnection{connection: connection{next: next}, items: make([]*userResolver, 0, len(cards))}
+	for _, card := range cards {
+		conn.items = append(conn.items, newUserCardResolver(root, *card))
+	}
+	return conn
+}
+
+type carResolver struct {
+	root *resolver
+	car  *models.CarCard
+}
+
+func (c *carResolver) ID() gql.ID {
+	return toID(c.car.ID)
+}
+
+func (c *carResolver) Name() string {
+	return c.car.Name
+}
+
+func (c *carResolver) Brand() string {
+	return c.car.Brand
+}
+
+func (c *carResolver) Model() string {
+	return c.car.Model
+}
+
+func (c *carResolver) Date() gql.Time {
+	return gql.Time{Time: c.car.Date}
+}
+
+func (c *carResolver) Body() string {
+	return c.car.Body
+}
+
+func (c *carResolver) Engine() string {
+	return c.car.Engine
+}
+
+func (c *carResolver) HorsePower() string {
+	return c.car.HorsePower
+}
+
+func (c *carResolver) Description() string {
+	return c.car.Description
+}
+
+func (c *carResolver) AvatarUrl() string {
+	return c.car.AvatarUrl
+}
+
+// Owner loads the owner, the car queries only fill in the id of the owner card.
+func (c *carResolver) Owner() *userResolver {
+	return &userResolver{root: c.root, id: c.car.Owner.VKID}
+}
+
+type carConnection struct {
+	connection
+	items []*carResolver
+}
+
+func (c *carConnection) Items() []*carResolver {
+	return c.items
+}
+
+func newCarConnection(root *resolver, cars []*models.CarCard, next *pagination.Cursor) *carConnection {
+	conn := &carConnection{connection: connection{next: next}, items: make([]*carResolver, 0, len(cars))}
+	for _, car := range cars {
+		conn.items = append(conn.items, &carResolver{root: root, car: car})
+	}
+	return conn
+}
@@ -0,0 +1,148 @@
+// Package grpcapi holds what the gRPC servers of the domains share: the conversion of the models
+// to the messages of pkg/carlifepb and of the Page message to a pagination.Page.
+package grpcapi
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+	"github.com/dantedoyl/car-life-api/pkg/carlifepb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strconv"
+	"time"
+)
+
+// MaxIDs bounds the ids of a batch request, like a page bounds a list.
+const MaxIDs = pagination.MaxLimit
+
+// Page turns the page of a request, which may be nil, into the page the usecases take.
+// A zero limit and an empty cursor are the defaults, as missing query parameters are for the HTTP API.
+func Page(page *carlifepb.Page) (pagination.Page, error) {
+	if page == nil {
+		return pagination.NewPage(nil, nil)
+	}
+
+	var limit *uint64
+	if page.Limit != 0 {
+		limit = &page.Limit
+	}
+	return pagination.NewPage(&page.Cursor, limit)
+}
+
+// NextCursor is the next_cursor and has_more of a list response, like pagination.NewResponse sets them.
+func NextCursor(next *pagination.Cursor) (string, bool) {
+	if next == nil {
+		return "", false
+	}
+	return next.Encode(), true
+}
+
+// CheckIDs refuses a batch request without ids or with more than MaxIDs of them.
+func CheckIDs(field string, ids []uint64) error {
+	if len(ids) == 0 || len(ids) > MaxIDs {
+		return apperrors.InvalidFields([]apperrors.FieldError{{
+			Field: field,
+			Error: "must have between 1 and " + strconv.Itoa(MaxIDs) + " ids",
+		}})
+	}
+	return nil
+}
+
+// CheckStatus refuses a status that isn't one of allowed.
+func CheckStatus(status string, allowed ...string) error {
+	for _, s := range allowed {
+		if status == s {
+			return nil
+		}
+	}
+	return apperrors.InvalidFields([]apperrors.FieldError{{Field: "status", Error: "is not a known status"}})
+}
+
+// Timestamp leaves a zero time unset rather than sending 0001-01-01.
+func Timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func UserCard(card models.UserCard) *carlifepb.UserCard {
+	return &carlifepb.UserCard{
+		VkId:      card.VKID,
+		Name:      card.Name,
+		Surname:   card.Surname,
+		AvatarUrl: card.AvatarUrl,
+	}
+}
+
+func UserCards(cards []*models.UserCard) []*carlifepb.UserCard {
+	items := make([]*carlifepb.UserCard, 0, len(cards))
+	for _, card := range cards {
+		items = append(items, UserCard(*card))
+	}
+	return items
+}
+
+func Car(car *models.CarCard) *carlifepb.Car {
+	return &carlifepb.Car{
+		Id:          car.ID,
+		Name:        car.Name,
+		Brand:       car.Brand,
+		Model:       car.Model,
+		Date:        Timestamp(car.Date),
+		Body:        car.Body,
+		Engine:      car.Engine,
+		HorsePower:  car.HorsePower,
+		Description: car.Description,
+		AvatarUrl:   car.AvatarUrl,
+		Owner:       &carlifepb.UserCard{VkId: car.Owner.VKID},
+	}
+}
+
+func Cars(cars []*models.CarCard) []*carlifepb.Car {
+	items := make([]*carlifepb.Car, 0, len(cars))
+	for _, car := range cars {
+		items = append(items, Car(car))
+	}
+	return items
+}
+
+func ClubCard(card *models.ClubCard) *carlifepb.ClubCard {
+	return &carlifepb.ClubCard{
+		Id:                card.ID,
+		Name:              card.Name,
+		AvatarUrl:         card.AvatarUrl,
+		Tags:              card.Tags,
+		ParticipantsCount: int64(card.ParticipantsCount),
+		SubscribersCount:  int64(card.SubscribersCount),
+	}
+}
+
+func ClubCards(cards []*models.ClubCard) []*carlifepb.ClubCard {
+	items := make([]*carlifepb.ClubCard, 0, len(cards))
+	for _, card := range cards {
+		items = append(items, ClubCard(card))
+	}
+	return items
+}
+
+func EventCard(card *models.EventCard) *carlifepb.EventCard {
+	return &carlifepb.EventCard{
+		Id:                card.ID,
+		Name:              card.Name,
+		EventDate:         Timestamp(card.EventDate),
+		AvatarUrl:         card.AvatarUrl,
+		Latitude:          card.Latitude,
+		Longitude:         card.Longitude,
+		ParticipantsCount: int64(card.ParticipantsCount),
+		SpectatorsCount:   int64(card.SpectatorsCount),
+	}
+}
+
+func EventCards(cards []*models.EventCard) []*carlifepb.EventCard {
+	items := make([]*carlifepb.EventCard, 0, len(cards))
+	for _, card := range cards {
+		items = append(items, EventCard(card))
+	}
+	return items
+}
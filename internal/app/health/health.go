@@ -0,0 +1,116 @@
+// Package health serves the liveness and readiness probes.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"github.com/gorilla/mux"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// Check reports whether a dependency is usable. It must return once ctx is done.
+type Check func(ctx context.Context) error
+
+// Status is public, so a dependency is only reported as "ok" or "unavailable";
+// the reason goes to the log.
+type Status struct {
+	Status       string            `json:"status"`
+	Dependencies map[string]string `json:"dependencies,omitempty"`
+}
+
+type Handler struct {
+	names    []string
+	checks   map[string]Check
+	timeout  time.Duration
+	draining int32
+}
+
+func NewHandler(timeout time.Duration) *Handler {
+	return &Handler{
+		checks:  map[string]Check{},
+		timeout: timeout,
+	}
+}
+
+func (h *Handler) Add(name string, check Check) {
+	h.names = append(h.names, name)
+	h.checks[name] = check
+}
+
+// SetDraining makes /readyz fail so that the balancer stops sending new requests
+// while the server is shutting down.
+func (h *Handler) SetDraining() {
+	atomic.StoreInt32(&h.draining, 1)
+}
+
+func (h *Handler) Configure(r *mux.Router) {
+	r.HandleFunc("/healthz", h.Healthz).Methods(http.MethodGet)
+	r.HandleFunc("/readyz", h.Readyz).Methods(http.MethodGet)
+}
+
+// Healthz answers 200 while the process is running. It doesn't look at the dependencies,
+// so that an outage of one of them doesn't get every instance restarted.
+func (h *Handler) Healthz(w http.ResponseWriter, r *http.Request) {
+	writeStatus(w, http.StatusOK, &Status{Status: "ok"})
+}
+
+// Readyz answers 503 if any dependency is down or the server is draining.
+func (h *Handler) Readyz(w http.ResponseWriter, r *http.Request) {
+	status := h.check(r.Context())
+	if atomic.LoadInt32(&h.draining) == 1 {
+		status.Status = "draining"
+	}
+
+	code := http.StatusOK
+	if status.Status != "ok" {
+		code = http.StatusServiceUnavailable
+	}
+	writeStatus(w, code, status)
+}
+
+func (h *Handler) check(ctx context.Context) *Status {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	type result struct {
+		name string
+		err  error
+	}
+	results := make(chan result, len(h.names))
+	for _, name := range h.names {
+		go func(name string, check Check) {
+			results <- result{name: name, err: check(ctx)}
+		}(name, h.checks[name])
+	}
+
+	status := &Status{
+		Status:       "ok",
+		Dependencies: make(map[string]string, len(h.names)),
+	}
+	for range h.names {
+		res := <-results
+		if res.err != nil {
+			logger.FromContext(ctx).Error("health check failed", "dependency", res.name, "error", res.err)
+			status.Status = "unavailable"
+			status.Dependencies[res.name] = "unavailable"
+			continue
+		}
+		status.Dependencies[res.name] = "ok"
+	}
+	return status
+}
+
+func writeStatus(w http.ResponseWriter, code int, status *Status) {
+	body, err := json.Marshal(status)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(body)
+}
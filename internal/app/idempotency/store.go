@@ -0,0 +1,39 @@
+// Package idempotency remembers the responses of create requests sent with an Idempotency-Key header,
+// so that a retried request gets the first response back instead of creating a duplicate.
+package idempotency
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"time"
+)
+
+var (
+	ErrKeyReused   = apperrors.Conflict("Idempotency-Key was already used for a different request")
+	ErrKeyInFlight = apperrors.Conflict("a request with this Idempotency-Key is still being processed")
+)
+
+// Record is a key of a user together with the request it was first sent with and, once that request
+// has finished, its response. Status is 0 while the first request is still running.
+type Record struct {
+	UserID      uint64
+	Key         string
+	RequestHash string
+	Status      int
+	ContentType string
+	Location    string
+	Body        []byte
+	CreatedAt   time.Time
+}
+
+type Store interface {
+	// Reserve claims the key of the user for the request with requestHash. Records created before
+	// notBefore have expired and are claimed anew. If the key is already claimed, Reserve leaves it
+	// as it is and returns its record; otherwise it returns nil.
+	Reserve(userID uint64, key string, requestHash string, notBefore time.Time) (*Record, error)
+	// Complete saves the response of the request that reserved the key.
+	Complete(record *Record) error
+	// Release drops a reserved key, so that the request can be retried for real.
+	Release(userID uint64, key string) error
+	// DeleteExpired removes the records created before notBefore and returns how many it removed.
+	DeleteExpired(notBefore time.Time) (int64, error)
+}
@@ -0,0 +1,82 @@
+package idempotency_postgres
+
+import (
+	"database/sql"
+	"github.com/dantedoyl/car-life-api/internal/app/idempotency"
+	"time"
+)
+
+type PostgresStore struct {
+	dbConn *sql.DB
+}
+
+func NewPostgresStore(conn *sql.DB) idempotency.Store {
+	return &PostgresStore{
+		dbConn: conn,
+	}
+}
+
+func (ps *PostgresStore) Reserve(userID uint64, key string, requestHash string, notBefore time.Time) (*idempotency.Record, error) {
+	// An expired record is taken over in place; a live one makes the insert return no rows.
+	var reserved uint64
+	err := ps.dbConn.QueryRow(
+		`INSERT INTO idempotency_keys (user_id, key, request_hash) VALUES ($1, $2, $3)
+				ON CONFLICT (user_id, key) DO UPDATE
+				SET request_hash = EXCLUDED.request_hash, status = 0, content_type = '', location = '', body = NULL,
+				    created_at = CURRENT_TIMESTAMP
+				WHERE idempotency_keys.created_at < $4
+				RETURNING user_id`, userID, key, requestHash, notBefore).Scan(&reserved)
+	if err == nil {
+		return nil, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	record := &idempotency.Record{}
+	var body []byte
+	err = ps.dbConn.QueryRow(
+		`SELECT user_id, key, request_hash, status, content_type, location, body, created_at FROM idempotency_keys
+				WHERE user_id = $1 AND key = $2`, userID, key).Scan(
+		&record.UserID, &record.Key, &record.RequestHash, &record.Status, &record.ContentType, &record.Location, &body, &record.CreatedAt)
+	if err == sql.ErrNoRows {
+		// Released between the two queries; the retry may go ahead.
+		return ps.Reserve(userID, key, requestHash, notBefore)
+	}
+	if err != nil {
+		return nil, err
+	}
+	record.Body = body
+
+	return record, nil
+}
+
+func (ps *PostgresStore) Complete(record *idempotency.Record) error {
+	_, err := ps.dbConn.Exec(
+		`UPDATE idempotency_keys SET status = $3, content_type = $4, location = $5, body = $6
+				WHERE user_id = $1 AND key = $2`,
+		record.UserID, record.Key, record.Status, record.ContentType, record.Location, record.Body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ps *PostgresStore) Release(userID uint64, key string) error {
+	_, err := ps.dbConn.Exec(`DELETE FROM idempotency_keys WHERE user_id = $1 AND key = $2`, userID, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ps *PostgresStore) DeleteExpired(notBefore time.Time) (int64, error) {
+	res, err := ps.dbConn.Exec(`DELETE FROM idempotency_keys WHERE created_at < $1`, notBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,98 @@
+// Package logger writes structured JSON log lines. A request-scoped logger carrying
+// the request id travels in the request context, so that everything logged while
+// handling a request can be matched with its access log line.
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+type contextKey struct{}
+
+type Logger struct {
+	mu     *sync.Mutex
+	out    io.Writer
+	fields map[string]interface{}
+}
+
+var defaultLogger = New(os.Stderr)
+
+func New(out io.Writer) *Logger {
+	return &Logger{
+		mu:     &sync.Mutex{},
+		out:    out,
+		fields: map[string]interface{}{},
+	}
+}
+
+// With returns a logger that adds the key-value pairs to every line.
+func (l *Logger) With(keysAndValues ...interface{}) *Logger {
+	fields := make(map[string]interface{}, len(l.fields)+len(keysAndValues)/2)
+	for k, v := range l.fields {
+		fields[k] = v
+	}
+	addFields(fields, keysAndValues)
+
+	return &Logger{
+		mu:     l.mu,
+		out:    l.out,
+		fields: fields,
+	}
+}
+
+func (l *Logger) Info(msg string, keysAndValues ...interface{}) {
+	l.write("info", msg, keysAndValues)
+}
+
+func (l *Logger) Error(msg string, keysAndValues ...interface{}) {
+	l.write("error", msg, keysAndValues)
+}
+
+func (l *Logger) write(level string, msg string, keysAndValues []interface{}) {
+	line := make(map[string]interface{}, len(l.fields)+len(keysAndValues)/2+3)
+	for k, v := range l.fields {
+		line[k] = v
+	}
+	addFields(line, keysAndValues)
+	line["time"] = time.Now().UTC().Format(time.RFC3339Nano)
+	line["level"] = level
+	line["msg"] = msg
+
+	data, err := json.Marshal(line)
+	if err != nil {
+		data = []byte(fmt.Sprintf(`{"level":"error","msg":"can't marshal log line: %v"}`, err))
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.out.Write(append(data, '\n'))
+}
+
+func addFields(fields map[string]interface{}, keysAndValues []interface{}) {
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		key := fmt.Sprint(keysAndValues[i])
+		value := keysAndValues[i+1]
+		if err, ok := value.(error); ok {
+			value = err.Error()
+		}
+		fields[key] = value
+	}
+}
+
+func WithLogger(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the request logger, or a logger writing to stderr outside of requests.
+func FromContext(ctx context.Context) *Logger {
+	if l, ok := ctx.Value(contextKey{}).(*Logger); ok {
+		return l
+	}
+	return defaultLogger
+}
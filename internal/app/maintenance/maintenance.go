@@ -0,0 +1,173 @@
+// Package maintenance holds the housekeeping jobs behind the cleanup and recount commands.
+package maintenance
+
+import (
+	"database/sql"
+	"github.com/dantedoyl/car-life-api/internal/app/idempotency"
+	"github.com/dantedoyl/car-life-api/internal/app/sessions"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+)
+
+// imageDirs are the upload directories of filesystem.InsertPhoto, relative to the working directory.
+var imageDirs = []string{"img/clubs", "img/events", "img/cars", "img/events-posts"}
+
+const defaultImage = "default.webp"
+
+type Maintenance struct {
+	dbConn      *sql.DB
+	sessions    sessions.SessionStore
+	idempotency idempotency.Store
+}
+
+func NewMaintenance(conn *sql.DB, sessionStore sessions.SessionStore, idempotencyStore idempotency.Store) *Maintenance {
+	return &Maintenance{
+		dbConn:      conn,
+		sessions:    sessionStore,
+		idempotency: idempotencyStore,
+	}
+}
+
+// DeleteEndedMiniEvents removes the mini events that ended before now.
+func (m *Maintenance) DeleteEndedMiniEvents(now time.Time) (int64, error) {
+	res, err := m.dbConn.Exec(`DELETE FROM mini_events WHERE ended_at < $1`, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (m *Maintenance) DeleteExpiredSessions(now time.Time) (int64, error) {
+	return m.sessions.DeleteExpired(now)
+}
+
+// DeleteExpiredIdempotencyKeys removes the stored create responses that were reserved before notBefore.
+func (m *Maintenance) DeleteExpiredIdempotencyKeys(notBefore time.Time) (int64, error) {
+	return m.idempotency.DeleteExpired(notBefore)
+}
+
+// DeleteOrphanedImages removes uploaded images that no club, event, car or post refers to.
+// Files modified after notAfter are kept, since their upload may not be saved to the database yet.
+// With dryRun set it only reports what it would remove.
+func (m *Maintenance) DeleteOrphanedImages(notAfter time.Time, dryRun bool) ([]string, error) {
+	referenced, err := m.referencedImages()
+	if err != nil {
+		return nil, err
+	}
+
+	var orphaned []string
+	for _, dir := range imageDirs {
+		entries, err := os.ReadDir(dir)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return orphaned, err
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || entry.Name() == defaultImage {
+				continue
+			}
+
+			imgUrl := "/" + path.Join(dir, entry.Name())
+			if _, ok := referenced[imgUrl]; ok {
+				continue
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				return orphaned, err
+			}
+			if info.ModTime().After(notAfter) {
+				continue
+			}
+
+			if !dryRun {
+				err = os.Remove(filepath.Join(dir, entry.Name()))
+				if err != nil {
+					return orphaned, err
+				}
+			}
+			orphaned = append(orphaned, imgUrl)
+		}
+	}
+
+	return orphaned, nil
+}
+
+func (m *Maintenance) referencedImages() (map[string]struct{}, error) {
+	rows, err := m.dbConn.Query(
+		`SELECT avatar FROM clubs
+				UNION SELECT avatar FROM events
+				UNION SELECT avatar FROM cars
+				UNION SELECT url FROM events_posts_attachments`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	referenced := map[string]struct{}{}
+	for rows.Next() {
+		var imgUrl string
+		err = rows.Scan(&imgUrl)
+		if err != nil {
+			return nil, err
+		}
+		referenced[imgUrl] = struct{}{}
+	}
+
+	return referenced, rows.Err()
+}
+
+// RecountClubs recomputes the participant, subscriber and event counters of the clubs
+// and returns how many clubs had drifted.
+func (m *Maintenance) RecountClubs() (int64, error) {
+	res, err := m.dbConn.Exec(
+		`UPDATE clubs AS c
+				SET participants_count = counts.participants,
+				    subscribers_count  = counts.subscribers,
+				    events_count       = counts.events
+				FROM (SELECT c.id,
+				             (SELECT count(*) FROM users_clubs AS uc
+				                 WHERE uc.club_id = c.id AND uc.status IN ('admin', 'moderator', 'participant')) AS participants,
+				             (SELECT count(*) FROM users_clubs AS uc
+				                 WHERE uc.club_id = c.id AND uc.status = 'subscriber') AS subscribers,
+				             (SELECT count(*) FROM events AS e
+				                 WHERE e.club_id = c.id AND e.hidden = false) AS events
+				      FROM clubs AS c) AS counts
+				WHERE c.id = counts.id
+				  AND (c.participants_count, c.subscribers_count, c.events_count)
+				      IS DISTINCT FROM (counts.participants, counts.subscribers, counts.events)`)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// RecountEvents recomputes the participant and spectator counters of the events
+// and returns how many events had drifted.
+func (m *Maintenance) RecountEvents() (int64, error) {
+	res, err := m.dbConn.Exec(
+		`UPDATE events AS e
+				SET participants_count = counts.participants,
+				    spectators_count   = counts.spectators
+				FROM (SELECT e.id,
+				             (SELECT count(*) FROM users_events AS ue
+				                 WHERE ue.event_id = e.id AND ue.status IN ('admin', 'participant')) AS participants,
+				             (SELECT count(*) FROM users_events AS ue
+				                 WHERE ue.event_id = e.id AND ue.status = 'spectator') AS spectators
+				      FROM events AS e) AS counts
+				WHERE e.id = counts.id
+				  AND (e.participants_count, e.spectators_count)
+				      IS DISTINCT FROM (counts.participants, counts.spectators)`)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/config"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const corsAllowedMethods = "GET, POST, PUT, PATCH, DELETE, OPTIONS"
+
+// CORS answers the browsers for the origins of cfg. A listed origin is echoed back with credentials
+// allowed, which the wildcard forbids; the session is read from the auth header, and credentials only
+// let the browser keep the session_id cookie the login sets. A lone * answers every origin with *
+// and without credentials, so that no site can make credentialed requests.
+type CORS struct {
+	origins        map[string]struct{}
+	anyOrigin      bool
+	wildcards      []originWildcard
+	allowedHeaders string
+	exposedHeaders string
+	maxAge         string
+}
+
+// originWildcard is an allowed origin with one * standing for any subdomains, like https://*.vk-apps.com.
+type originWildcard struct {
+	prefix, suffix string
+}
+
+func NewCORS(cfg config.CORSConfig) *CORS {
+	c := &CORS{
+		origins:        map[string]struct{}{},
+		allowedHeaders: strings.Join(cfg.AllowedHeaders, ", "),
+		exposedHeaders: strings.Join(cfg.ExposedHeaders, ", "),
+		maxAge:         strconv.Itoa(int(cfg.MaxAge.Seconds())),
+	}
+
+	for _, origin := range cfg.AllowedOrigins {
+		origin = strings.ToLower(strings.TrimSpace(origin))
+		switch {
+		case origin == "*":
+			c.anyOrigin = true
+		case strings.Contains(origin, "*"):
+			parts := strings.SplitN(origin, "*", 2)
+			c.wildcards = append(c.wildcards, originWildcard{prefix: parts[0], suffix: parts[1]})
+		default:
+			c.origins[origin] = struct{}{}
+		}
+	}
+
+	return c
+}
+
+// Middleware adds the CORS headers for an allowed Origin and answers every OPTIONS request itself,
+// so the handlers never see a preflight. Requests from other origins get no CORS headers,
+// which makes the browser drop the response.
+func (c *CORS) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Origin")
+
+		origin := r.Header.Get("Origin")
+		if origin != "" && c.allowed(origin) {
+			if c.anyOrigin {
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+			} else {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				w.Header().Set("Access-Control-Allow-Credentials", "true")
+			}
+			if c.exposedHeaders != "" {
+				w.Header().Set("Access-Control-Expose-Headers", c.exposedHeaders)
+			}
+
+			if r.Method == http.MethodOptions {
+				w.Header().Set("Access-Control-Allow-Methods", corsAllowedMethods)
+				w.Header().Set("Access-Control-Allow-Headers", c.allowedHeaders)
+				w.Header().Set("Access-Control-Max-Age", c.maxAge)
+			}
+		}
+
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (c *CORS) allowed(origin string) bool {
+	if c.anyOrigin {
+		return true
+	}
+
+	origin = strings.ToLower(origin)
+	if _, ok := c.origins[origin]; ok {
+		return true
+	}
+
+	for _, w := range c.wildcards {
+		if len(origin) > len(w.prefix)+len(w.suffix) &&
+			strings.HasPrefix(origin, w.prefix) && strings.HasSuffix(origin, w.suffix) &&
+			!strings.ContainsAny(origin[len(w.prefix):len(origin)-len(w.suffix)], "/:") {
+			return true
+		}
+	}
+
+	return false
+}
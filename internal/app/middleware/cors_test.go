@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/config"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCORSMiddleware(t *testing.T) {
+	tests := []struct {
+		name            string
+		allowed         []string
+		origin          string
+		wantOrigin      string
+		wantCredentials string
+	}{
+		{"listed origin", []string{"https://vk.com"}, "https://vk.com", "https://vk.com", "true"},
+		{"subdomain wildcard", []string{"https://*.vk-apps.com"}, "https://app.vk-apps.com", "https://app.vk-apps.com", "true"},
+		{"wildcard needs a subdomain", []string{"https://*.vk-apps.com"}, "https://.vk-apps.com", "", ""},
+		{"other origin", []string{"https://vk.com"}, "https://evil.example", "", ""},
+		{"any origin is not credentialed", []string{"*"}, "https://evil.example", "*", ""},
+		{"any origin wins over the list", []string{"https://vk.com", "*"}, "https://vk.com", "*", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cors := NewCORS(config.CORSConfig{AllowedOrigins: tt.allowed})
+			handler := cors.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Origin", tt.origin)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, r)
+
+			if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, tt.wantOrigin)
+			}
+			if got := rec.Header().Get("Access-Control-Allow-Credentials"); got != tt.wantCredentials {
+				t.Errorf("Access-Control-Allow-Credentials = %q, want %q", got, tt.wantCredentials)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+	"time"
+)
+
+// The metadata keys of the gRPC API. A session goes in auth like it does in the header of the HTTP API.
+const (
+	GRPCAuthKey      = "auth"
+	GRPCRequestIDKey = "x-request-id"
+)
+
+var grpcCodeByCode = map[apperrors.Code]codes.Code{
+	apperrors.CodeValidation:   codes.InvalidArgument,
+	apperrors.CodeUnauthorized: codes.Unauthenticated,
+	apperrors.CodeForbidden:    codes.PermissionDenied,
+	apperrors.CodeNotFound:     codes.NotFound,
+	apperrors.CodeConflict:     codes.AlreadyExists,
+	apperrors.CodeRateLimited:  codes.ResourceExhausted,
+}
+
+// GRPCInterceptors are the interceptors of the gRPC server, in the order RequestLogMiddleware,
+// utils.WriteError and CheckAuthMiddleware wrap an HTTP handler.
+func (m *Middleware) GRPCInterceptors() []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{m.GRPCRequestLog, GRPCErrors, m.GRPCCheckAuth}
+}
+
+// GRPCRequestLog is RequestLogMiddleware for a gRPC call: the request id comes from
+// the x-request-id metadata and is sent back in the response header.
+func (m *Middleware) GRPCRequestLog(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+
+	requestID := firstMetadata(ctx, GRPCRequestIDKey)
+	if !validRequestID(requestID) {
+		requestID = uuid.New().String()
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(GRPCRequestIDKey, requestID))
+
+	log := m.log.With("request_id", requestID)
+	state := &requestState{}
+	ctx = logger.WithLogger(ctx, log)
+	ctx = context.WithValue(ctx, requestStateKey{}, state)
+
+	resp, err := handler(ctx, req)
+
+	fields := []interface{}{
+		"method", info.FullMethod,
+		"code", status.Code(err).String(),
+		"latency_ms", float64(time.Since(start).Microseconds()) / 1000,
+		"ip", peerIP(ctx),
+	}
+	if state.userID != 0 {
+		fields = append(fields, "user_id", state.userID)
+	}
+	log.Info("request", fields...)
+
+	return resp, err
+}
+
+// GRPCErrors turns the apperrors of a call into statuses with the matching codes. The invalid fields
+// of a validation error go in a BadRequest detail, and internal errors are logged and hidden.
+func GRPCErrors(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err == nil {
+		return resp, nil
+	}
+
+	var appErr *apperrors.Error
+	if !errors.As(err, &appErr) {
+		logger.FromContext(ctx).Error("request failed", "error", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	code, ok := grpcCodeByCode[appErr.Code]
+	if !ok {
+		code = codes.Internal
+	}
+	st := status.New(code, appErr.Error())
+	if len(appErr.Fields) == 0 {
+		return nil, st.Err()
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, field := range appErr.Fields {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field.Field,
+			Description: field.Error,
+		})
+	}
+	detailed, detailErr := st.WithDetails(badRequest)
+	if detailErr != nil {
+		return nil, st.Err()
+	}
+	return nil, detailed.Err()
+}
+
+// GRPCCheckAuth is CheckAuthMiddleware for a gRPC call, with the session in the auth metadata.
+func (m *Middleware) GRPCCheckAuth(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	sessionValue := firstMetadata(ctx, GRPCAuthKey)
+	if sessionValue == "" {
+		return handler(ctx, req)
+	}
+
+	session, err := m.userUcase.CheckSession(sessionValue)
+	if err != nil {
+		return handler(ctx, req)
+	}
+
+	if state, ok := ctx.Value(requestStateKey{}).(*requestState); ok {
+		state.userID = session.UserID
+	}
+	ctx = logger.WithLogger(ctx, logger.FromContext(ctx).With("user_id", session.UserID))
+	ctx = context.WithValue(ctx, "userID", session.UserID)
+	ctx = context.WithValue(ctx, "sessionValue", session.Value)
+	return handler(ctx, req)
+}
+
+func firstMetadata(ctx context.Context, key string) string {
+	values := metadata.ValueFromIncomingContext(ctx, key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/idempotency"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"github.com/dantedoyl/car-life-api/internal/app/utils"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	maxIdempotencyKeyLength = 255
+	maxIdempotentBodySize   = 1 << 20
+)
+
+// Idempotent makes a create endpoint safe to retry. The first response to a request with an
+// Idempotency-Key header is saved for the user and the key and replayed to every retry,
+// so that the handler runs only once. Only successes and deterministic client errors are saved;
+// after any other answer or a panic the key is released. Reusing a key for a different request is a conflict.
+// Requests without the header pass through. It must run after CheckAuthMiddleware.
+func (m *Middleware) Idempotent(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Idempotency-Key")
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		userID, ok := r.Context().Value("userID").(uint64)
+		if !ok {
+			utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			utils.WriteError(w, r, apperrors.InvalidFields([]apperrors.FieldError{{
+				Field: "Idempotency-Key",
+				Error: "must be at most 255 characters long",
+			}}))
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodySize))
+		r.Body.Close()
+		if err != nil {
+			utils.WriteError(w, r, apperrors.Validation("can't read request body"))
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		sum := sha256.New()
+		sum.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+		sum.Write(body)
+		requestHash := hex.EncodeToString(sum.Sum(nil))
+
+		record, err := m.idempotency.Reserve(userID, key, requestHash, time.Now().Add(-m.idempotencyTTL))
+		if err != nil {
+			utils.WriteError(w, r, err)
+			return
+		}
+
+		if record != nil {
+			switch {
+			case record.RequestHash != requestHash:
+				utils.WriteError(w, r, idempotency.ErrKeyReused)
+			case record.Status == 0:
+				utils.WriteError(w, r, idempotency.ErrKeyInFlight)
+			default:
+				replay(w, record)
+			}
+			return
+		}
+
+		defer func() {
+			if p := recover(); p != nil {
+				// The key would stay in flight until it expires, so it is given back before the panic goes on.
+				err := m.idempotency.Release(userID, key)
+				if err != nil {
+					logger.FromContext(r.Context()).Error("releasing idempotency key failed", "error", err)
+				}
+				panic(p)
+			}
+		}()
+
+		rec := &bufferedResponse{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		// Any other answer may not have done anything or may change on a retry, such as a failed
+		// request, a refused session or a rate limit, so its key is given back for a real retry.
+		if !replayable(rec.status) {
+			err = m.idempotency.Release(userID, key)
+		} else {
+			err = m.idempotency.Complete(&idempotency.Record{
+				UserID:      userID,
+				Key:         key,
+				RequestHash: requestHash,
+				Status:      rec.status,
+				ContentType: w.Header().Get("Content-Type"),
+				Location:    w.Header().Get("Location"),
+				Body:        rec.body.Bytes(),
+			})
+		}
+		if err != nil {
+			logger.FromContext(r.Context()).Error("saving idempotent response failed", "error", err)
+		}
+
+		w.WriteHeader(rec.status)
+		w.Write(rec.body.Bytes())
+	}
+}
+
+// replayable reports whether a response with status is saved for the retries: a success or
+// a client error that the same request gets every time.
+func replayable(status int) bool {
+	switch status {
+	case http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity:
+		return true
+	}
+	return status >= http.StatusOK && status < http.StatusMultipleChoices
+}
+
+func replay(w http.ResponseWriter, record *idempotency.Record) {
+	if record.ContentType != "" {
+		w.Header().Set("Content-Type", record.ContentType)
+	}
+	if record.Location != "" {
+		w.Header().Set("Location", record.Location)
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(record.Status)
+	w.Write(record.Body)
+}
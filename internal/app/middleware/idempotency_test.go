@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/idempotency"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const idempotentUserID = 42
+
+// fakeIdempotencyStore keeps the records of one user in memory and ignores expiry.
+type fakeIdempotencyStore struct {
+	records map[string]*idempotency.Record
+}
+
+func newFakeIdempotencyStore() *fakeIdempotencyStore {
+	return &fakeIdempotencyStore{records: map[string]*idempotency.Record{}}
+}
+
+func (fs *fakeIdempotencyStore) Reserve(userID uint64, key string, requestHash string, notBefore time.Time) (*idempotency.Record, error) {
+	if record, ok := fs.records[key]; ok {
+		return record, nil
+	}
+	fs.records[key] = &idempotency.Record{UserID: userID, Key: key, RequestHash: requestHash}
+	return nil, nil
+}
+
+func (fs *fakeIdempotencyStore) Complete(record *idempotency.Record) error {
+	fs.records[record.Key] = record
+	return nil
+}
+
+func (fs *fakeIdempotencyStore) Release(userID uint64, key string) error {
+	delete(fs.records, key)
+	return nil
+}
+
+func (fs *fakeIdempotencyStore) DeleteExpired(notBefore time.Time) (int64, error) {
+	return 0, nil
+}
+
+func idempotentRequest(key string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/clubs", strings.NewReader(`{"name":"Club"}`))
+	r.Header.Set("Idempotency-Key", key)
+	return r.WithContext(context.WithValue(r.Context(), "userID", uint64(idempotentUserID)))
+}
+
+func TestIdempotentStoresOnlyReplayableAnswers(t *testing.T) {
+	tests := []struct {
+		status int
+		stored bool
+	}{
+		{http.StatusOK, true},
+		{http.StatusCreated, true},
+		{http.StatusBadRequest, true},
+		{http.StatusNotFound, true},
+		{http.StatusConflict, true},
+		{http.StatusUnprocessableEntity, true},
+		{http.StatusUnauthorized, false},
+		{http.StatusForbidden, false},
+		{http.StatusTooManyRequests, false},
+		{http.StatusInternalServerError, false},
+		{http.StatusServiceUnavailable, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			store := newFakeIdempotencyStore()
+			m := NewMiddleware(nil, nil, store, time.Hour, nil, nil, nil, logger.New(io.Discard))
+			calls := 0
+			handler := m.Idempotent(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.WriteHeader(tt.status)
+			})
+
+			for i := 0; i < 2; i++ {
+				rec := httptest.NewRecorder()
+				handler(rec, idempotentRequest("key"))
+				if rec.Code != tt.status {
+					t.Fatalf("request %d answered %d, want %d", i+1, rec.Code, tt.status)
+				}
+				replayed := rec.Header().Get("Idempotent-Replayed") == "true"
+				if replayed != (tt.stored && i == 1) {
+					t.Fatalf("request %d replayed = %v", i+1, replayed)
+				}
+			}
+
+			wantCalls := 2
+			if tt.stored {
+				wantCalls = 1
+			}
+			if calls != wantCalls {
+				t.Fatalf("handler ran %d times, want %d", calls, wantCalls)
+			}
+		})
+	}
+}
+
+func TestIdempotentReleasesKeyOnPanic(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	m := NewMiddleware(nil, nil, store, time.Hour, nil, nil, nil, logger.New(io.Discard))
+	panicking := m.Idempotent(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+
+	func() {
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Fatalf("recovered %v, want the handler's panic to go on", p)
+			}
+		}()
+		panicking(httptest.NewRecorder(), idempotentRequest("key"))
+	}()
+
+	if _, ok := store.records["key"]; ok {
+		t.Fatal("the key is still reserved after the panic")
+	}
+
+	retry := m.Idempotent(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+	rec := httptest.NewRecorder()
+	retry(rec, idempotentRequest("key"))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("retry answered %d, want %d", rec.Code, http.StatusCreated)
+	}
+}
@@ -2,36 +2,174 @@ package middleware
 
 import (
 	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+	"github.com/dantedoyl/car-life-api/internal/app/idempotency"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"github.com/dantedoyl/car-life-api/internal/app/metrics"
+	"github.com/dantedoyl/car-life-api/internal/app/ratelimit"
 	users "github.com/dantedoyl/car-life-api/internal/app/users"
+	"github.com/dantedoyl/car-life-api/internal/app/utils"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	"net/http"
+	"strconv"
+	"time"
 )
 
+const maxRequestIDLength = 128
+
 type Middleware struct {
-	userUcase users.IUsersUsecase
+	userUcase      users.IUsersUsecase
+	authUcase      authorization.IAuthorizationUsecase
+	idempotency    idempotency.Store
+	idempotencyTTL time.Duration
+	rateLimiter    ratelimit.Store
+	rateLimits     ratelimit.Limits
+	trustedProxies utils.TrustedProxies
+	log            *logger.Logger
 }
 
-func NewMiddleware(userUcase users.IUsersUsecase) *Middleware {
+func NewMiddleware(userUcase users.IUsersUsecase, authUcase authorization.IAuthorizationUsecase,
+	idempotencyStore idempotency.Store, idempotencyTTL time.Duration,
+	rateLimiter ratelimit.Store, rateLimits ratelimit.Limits, trustedProxies utils.TrustedProxies, log *logger.Logger) *Middleware {
 	return &Middleware{
-		userUcase: userUcase,
+		userUcase:      userUcase,
+		authUcase:      authUcase,
+		idempotency:    idempotencyStore,
+		idempotencyTTL: idempotencyTTL,
+		rateLimiter:    rateLimiter,
+		rateLimits:     rateLimits,
+		trustedProxies: trustedProxies,
+		log:            log,
 	}
 }
 
-func CorsControlMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Headers", "content-type")
-		w.Header().Set("Access-Control-Expose-Headers", "X-CSRF-Token")
-		w.Header().Set("Access-Control-Allow-Credentials", "true")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE")
+type requestStateKey struct{}
 
-		if req.Method == "OPTIONS" {
-			return
+// requestState is filled in by the inner middlewares and read by RequestLogMiddleware
+// once the request is done, because the contexts they create don't reach it.
+type requestState struct {
+	userID uint64
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (sr *statusRecorder) WriteHeader(status int) {
+	sr.status = status
+	sr.ResponseWriter.WriteHeader(status)
+}
+
+func (sr *statusRecorder) Write(b []byte) (int, error) {
+	n, err := sr.ResponseWriter.Write(b)
+	sr.bytes += n
+	return n, err
+}
+
+// RequestLogMiddleware gives the request an id (the client's X-Request-ID if it sent a sane one),
+// puts a logger carrying it and the client address into the context and writes one access log line
+// when the request is done.
+func (m *Middleware) RequestLogMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		requestID := r.Header.Get("X-Request-ID")
+		if !validRequestID(requestID) {
+			requestID = uuid.New().String()
+		}
+		w.Header().Set("X-Request-ID", requestID)
+
+		log := m.log.With("request_id", requestID)
+		ip := m.trustedProxies.ClientIP(r)
+		state := &requestState{}
+		ctx := logger.WithLogger(r.Context(), log)
+		ctx = utils.WithClientIP(ctx, ip)
+		ctx = context.WithValue(ctx, requestStateKey{}, state)
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		route := routeTemplate(r)
+		if route == "" {
+			route = r.URL.Path
 		}
 
-		next.ServeHTTP(w, req)
+		fields := []interface{}{
+			"method", r.Method,
+			"route", route,
+			"status", recorder.status,
+			"latency_ms", float64(time.Since(start).Microseconds()) / 1000,
+			"bytes", recorder.bytes,
+			"ip", ip,
+		}
+		if state.userID != 0 {
+			fields = append(fields, "user_id", state.userID)
+		}
+		log.Info("request", fields...)
+	})
+}
+
+// MetricsMiddleware counts requests and observes their latency labeled by the mux route template,
+// so that paths with ids don't blow up the number of series.
+func MetricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		route := routeTemplate(r)
+		if route == "" {
+			route = "unmatched"
+		}
+		metrics.HTTPRequests.WithLabelValues(r.Method, route, strconv.Itoa(recorder.status)).Inc()
+		metrics.ObserveSince(metrics.HTTPRequestDuration.WithLabelValues(r.Method, route), start)
 	})
 }
 
+func routeTemplate(r *http.Request) string {
+	current := mux.CurrentRoute(r)
+	if current == nil {
+		return ""
+	}
+	template, err := current.GetPathTemplate()
+	if err != nil {
+		return ""
+	}
+	return template
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		if c < '!' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// WithVars adds fixed route variables to the ones mux matched. It lets a route with a literal path,
+// like /clubs/{id}/subscribers in v2, reuse a handler written for a variable one, like /clubs/{id}/{type} in v1.
+func WithVars(vars map[string]string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		merged := map[string]string{}
+		for k, v := range mux.Vars(r) {
+			merged[k] = v
+		}
+		for k, v := range vars {
+			merged[k] = v
+		}
+
+		next.ServeHTTP(w, mux.SetURLVars(r, merged))
+	}
+}
+
 func (m *Middleware) CheckAuthMiddleware(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		header := r.Header.Values("auth")
@@ -47,7 +185,58 @@ func (m *Middleware) CheckAuthMiddleware(next http.HandlerFunc) http.HandlerFunc
 		}
 
 		ctx := r.Context()
+		if state, ok := ctx.Value(requestStateKey{}).(*requestState); ok {
+			state.userID = session.UserID
+		}
+		ctx = logger.WithLogger(ctx, logger.FromContext(ctx).With("user_id", session.UserID))
 		ctx = context.WithValue(ctx, "userID", session.UserID)
+		ctx = context.WithValue(ctx, "sessionValue", session.Value)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	}
 }
+
+// RequirePermission lets the request through only if the authorization policy allows
+// the current user to perform the action on the resource whose id is in the idVar route variable.
+// It has to be wrapped by CheckAuthMiddleware.
+func (m *Middleware) RequirePermission(action authorization.Action, idVar string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := r.Context().Value("userID").(uint64)
+		if !ok {
+			utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+			return
+		}
+
+		resourceID, err := strconv.ParseUint(mux.Vars(r)[idVar], 10, 64)
+		if err != nil {
+			utils.WriteError(w, r, apperrors.Validation("invalid "+idVar))
+			return
+		}
+
+		err = m.authUcase.Can(userID, action, resourceID)
+		if err != nil {
+			utils.WriteError(w, r, err)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+}
+
+// RequirePlatformPermission is RequirePermission for platform-wide actions that are not tied to a resource.
+func (m *Middleware) RequirePlatformPermission(action authorization.Action, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := r.Context().Value("userID").(uint64)
+		if !ok {
+			utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+			return
+		}
+
+		err := m.authUcase.Can(userID, action, 0)
+		if err != nil {
+			utils.WriteError(w, r, err)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+}
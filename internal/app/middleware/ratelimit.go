@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/metrics"
+	"github.com/dantedoyl/car-life-api/internal/app/ratelimit"
+	"github.com/dantedoyl/car-life-api/internal/app/utils"
+	"math"
+	"net/http"
+	"strconv"
+)
+
+// RateLimit takes a token of class for the client before next runs and answers 429 with Retry-After
+// when the bucket is empty. Behind CheckAuthMiddleware the bucket is the user's, otherwise the client IP's.
+// A class without a configured limit passes everything through.
+func (m *Middleware) RateLimit(class ratelimit.Class, next http.HandlerFunc) http.HandlerFunc {
+	limit, ok := m.rateLimits[class]
+	if !ok || !limit.Enabled() {
+		return next
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := string(class) + ":ip:" + utils.ClientIP(r)
+		if userID, ok := r.Context().Value("userID").(uint64); ok {
+			key = string(class) + ":user:" + strconv.FormatUint(userID, 10)
+		}
+
+		wait, err := m.rateLimiter.Take(key, limit)
+		if err != nil {
+			utils.WriteError(w, r, err)
+			return
+		}
+
+		if wait > 0 {
+			metrics.RateLimitedRequests.WithLabelValues(string(class)).Inc()
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			utils.WriteError(w, r, apperrors.RateLimited("too many requests, try again later"))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+}
@@ -0,0 +1,22 @@
+DROP TABLE IF EXISTS complaints;
+DROP TYPE IF EXISTS target_type;
+
+DROP TABLE IF EXISTS events_posts_attachments;
+DROP TABLE IF EXISTS events_posts;
+
+DROP TABLE IF EXISTS mini_events;
+DROP TABLE IF EXISTS mini_event_type;
+
+DROP TABLE IF EXISTS users_events;
+DROP TYPE IF EXISTS user_event_status;
+DROP TABLE IF EXISTS users_clubs;
+DROP TYPE IF EXISTS user_club_status;
+
+DROP TABLE IF EXISTS cars;
+DROP TABLE IF EXISTS tags;
+DROP TABLE IF EXISTS events;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS clubs;
+
+DROP EXTENSION IF EXISTS postgis_topology;
+DROP EXTENSION IF EXISTS postgis;
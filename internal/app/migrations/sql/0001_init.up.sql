@@ -1,9 +1,8 @@
-CREATE EXTENSION postgis;
-CREATE EXTENSION postgis_topology;
-
--- GRANT ALL PRIVILEGES ON database car_life_api TO postgres;
--- ALTER USER postgres WITH PASSWORD 'ysnpkoyapassword';
-
+-- The schema of config/sql/init_db.sql, with users created before the tables that reference it.
+-- Every statement is a no-op on a database that was created from that file, so migrate up
+-- can be run on one as it is.
+CREATE EXTENSION IF NOT EXISTS postgis;
+CREATE EXTENSION IF NOT EXISTS postgis_topology;
 
 CREATE TABLE IF NOT EXISTS clubs
 (
@@ -19,6 +18,17 @@ CREATE TABLE IF NOT EXISTS clubs
     subscribers_count  INT                   DEFAULT 0
 );
 
+CREATE TABLE IF NOT EXISTS users
+(
+    vk_id       BIGINT PRIMARY KEY,
+    name        TEXT         NOT NULL,
+    surname     TEXT         NOT NULL,
+    avatar      VARCHAR(512) NOT NULL,
+    tags        TEXT[],
+    description TEXT,
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
 CREATE TABLE IF NOT EXISTS events
 (
     id                 BIGSERIAL PRIMARY KEY,
@@ -46,17 +56,6 @@ CREATE TABLE IF NOT EXISTS tags
     usage_count INT DEFAULT 0
 );
 
-CREATE TABLE IF NOT EXISTS users
-(
-    vk_id       BIGINT PRIMARY KEY,
-    name        TEXT         NOT NULL,
-    surname     TEXT         NOT NULL,
-    avatar      VARCHAR(512) NOT NULL,
-    tags        TEXT[],
-    description TEXT,
-    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-);
-
 CREATE TABLE IF NOT EXISTS cars
 (
     id          BIGSERIAL PRIMARY KEY,
@@ -74,7 +73,14 @@ CREATE TABLE IF NOT EXISTS cars
     FOREIGN KEY (owner_id) REFERENCES users (vk_id) ON DELETE CASCADE
 );
 
-CREATE TYPE user_club_status AS ENUM ('admin', 'participant', 'participant_request', 'subscriber', 'moderator');
+DO
+$$
+BEGIN
+    CREATE TYPE user_club_status AS ENUM ('admin', 'participant', 'participant_request', 'subscriber', 'moderator');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END;
+$$;
 CREATE TABLE IF NOT EXISTS users_clubs
 (
     user_id BIGINT,
@@ -86,7 +92,14 @@ CREATE TABLE IF NOT EXISTS users_clubs
     FOREIGN KEY (club_id) REFERENCES clubs (id) ON DELETE CASCADE
 );
 
-CREATE TYPE user_event_status AS ENUM ('admin', 'participant', 'participant_request', 'spectator');
+DO
+$$
+BEGIN
+    CREATE TYPE user_event_status AS ENUM ('admin', 'participant', 'participant_request', 'spectator');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END;
+$$;
 CREATE TABLE IF NOT EXISTS users_events
 (
     user_id  BIGINT,
@@ -141,7 +154,14 @@ CREATE TABLE IF NOT EXISTS events_posts_attachments
     FOREIGN KEY (post_id) REFERENCES events_posts (id) ON DELETE CASCADE
 );
 
-CREATE TYPE target_type AS ENUM ('club', 'event', 'post', 'car', 'user');
+DO
+$$
+BEGIN
+    CREATE TYPE target_type AS ENUM ('club', 'event', 'post', 'car', 'user');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END;
+$$;
 CREATE TABLE IF NOT EXISTS complaints
 (
     id          BIGSERIAL PRIMARY KEY,
@@ -154,31 +174,35 @@ CREATE TABLE IF NOT EXISTS complaints
 );
 
 INSERT INTO mini_event_type (public_name, public_description)
-VALUES ('Помощь', 'Нужна помощь'),
-       ('Мини-сходка', 'Организуй встречу с друзьями'),
-       ('Проишествие', 'Оставь, если что-то произошло на дороге');
+SELECT public_name, public_description
+FROM (VALUES ('Помощь', 'Нужна помощь'),
+             ('Мини-сходка', 'Организуй встречу с друзьями'),
+             ('Проишествие', 'Оставь, если что-то произошло на дороге')) AS t (public_name, public_description)
+WHERE NOT EXISTS(SELECT 1 FROM mini_event_type);
 
 INSERT INTO tags (name, usage_count)
-VALUES ('jdm', 0),
-       ('vintage', 0),
-       ('4x4', 0),
-       ('racing', 0),
-       ('cars&coffee', 0),
-       ('exclusive car clubs', 0),
-       ('photography', 0),
-       ('motosports', 0),
-       ('supercar', 0),
-       ('local', 0),
-       ('drift', 0),
-       ('brand specific', 0),
-       ('Янгтаймер', 0),
-       ('Олдтаймер', 0),
-       ('Edm', 0),
-       ('Stance', 0),
-       ('Racecar', 0),
-       ('Offroad', 0),
-       ('Traveler', 0),
-       ('Street warrior', 0),
-       ('Coupe', 0),
-       ('Suv', 0),
-       ('Usdm', 0);
+SELECT name, usage_count
+FROM (VALUES ('jdm', 0),
+             ('vintage', 0),
+             ('4x4', 0),
+             ('racing', 0),
+             ('cars&coffee', 0),
+             ('exclusive car clubs', 0),
+             ('photography', 0),
+             ('motosports', 0),
+             ('supercar', 0),
+             ('local', 0),
+             ('drift', 0),
+             ('brand specific', 0),
+             ('Янгтаймер', 0),
+             ('Олдтаймер', 0),
+             ('Edm', 0),
+             ('Stance', 0),
+             ('Racecar', 0),
+             ('Offroad', 0),
+             ('Traveler', 0),
+             ('Street warrior', 0),
+             ('Coupe', 0),
+             ('Suv', 0),
+             ('Usdm', 0)) AS t (name, usage_count)
+WHERE NOT EXISTS(SELECT 1 FROM tags);
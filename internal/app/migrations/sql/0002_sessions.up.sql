@@ -0,0 +1,17 @@
+-- Sessions in Postgres, for the postgres session store.
+CREATE TABLE IF NOT EXISTS sessions
+(
+    value        TEXT PRIMARY KEY,
+    id           TEXT      NOT NULL UNIQUE,
+    family_id    TEXT      NOT NULL DEFAULT '',
+    user_id      BIGINT    NOT NULL,
+    expires_at   TIMESTAMP NOT NULL,
+    created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    user_agent   TEXT      NOT NULL DEFAULT '',
+    ip           TEXT      NOT NULL DEFAULT '',
+
+    FOREIGN KEY (user_id) REFERENCES users (vk_id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS sessions_user_id_idx ON sessions (user_id);
+CREATE INDEX IF NOT EXISTS sessions_family_id_idx ON sessions (family_id);
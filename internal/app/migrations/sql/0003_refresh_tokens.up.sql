@@ -0,0 +1,14 @@
+-- Refresh tokens of the postgres session store. A family is the chain of tokens rotated from one login.
+CREATE TABLE IF NOT EXISTS refresh_tokens
+(
+    value         TEXT PRIMARY KEY,
+    family_id     TEXT      NOT NULL,
+    user_id       BIGINT    NOT NULL,
+    session_value TEXT      NOT NULL,
+    expires_at    TIMESTAMP NOT NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    used          BOOLEAN   NOT NULL DEFAULT false,
+
+    FOREIGN KEY (user_id) REFERENCES users (vk_id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS refresh_tokens_family_id_idx ON refresh_tokens (family_id);
@@ -0,0 +1,25 @@
+DROP TABLE IF EXISTS complaints_history;
+
+DROP INDEX IF EXISTS complaints_target_type_status_idx;
+ALTER TABLE complaints
+    DROP COLUMN IF EXISTS resolution,
+    DROP COLUMN IF EXISTS resolved_at,
+    DROP COLUMN IF EXISTS resolved_by,
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS status;
+DROP TYPE IF EXISTS complaint_action;
+DROP TYPE IF EXISTS complaint_status;
+
+ALTER TABLE events_posts
+    DROP COLUMN IF EXISTS hidden;
+ALTER TABLE cars
+    DROP COLUMN IF EXISTS hidden;
+ALTER TABLE events
+    DROP COLUMN IF EXISTS hidden;
+ALTER TABLE clubs
+    DROP COLUMN IF EXISTS hidden;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS banned,
+    DROP COLUMN IF EXISTS role;
+DROP TYPE IF EXISTS user_role;
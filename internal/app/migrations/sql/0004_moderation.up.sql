@@ -0,0 +1,59 @@
+-- Platform staff, bans, content hidden by moderation and the complaint workflow.
+DO
+$$
+BEGIN
+    CREATE TYPE user_role AS ENUM ('user', 'staff');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END;
+$$;
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS role   user_role NOT NULL DEFAULT 'user',
+    ADD COLUMN IF NOT EXISTS banned BOOLEAN   NOT NULL DEFAULT false;
+
+ALTER TABLE clubs
+    ADD COLUMN IF NOT EXISTS hidden BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS hidden BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE cars
+    ADD COLUMN IF NOT EXISTS hidden BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE events_posts
+    ADD COLUMN IF NOT EXISTS hidden BOOLEAN NOT NULL DEFAULT false;
+
+DO
+$$
+BEGIN
+    CREATE TYPE complaint_status AS ENUM ('open', 'resolved', 'dismissed');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END;
+$$;
+DO
+$$
+BEGIN
+    CREATE TYPE complaint_action AS ENUM ('dismiss', 'hide', 'delete', 'ban');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END;
+$$;
+-- Complaints filed before this migration are open and get the time of the migration as created_at.
+ALTER TABLE complaints
+    ADD COLUMN IF NOT EXISTS status      complaint_status NOT NULL DEFAULT 'open',
+    ADD COLUMN IF NOT EXISTS created_at  TIMESTAMP        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS resolved_by BIGINT           NULL REFERENCES users (vk_id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS resolved_at TIMESTAMP        NULL,
+    ADD COLUMN IF NOT EXISTS resolution  complaint_action NULL;
+CREATE INDEX IF NOT EXISTS complaints_target_type_status_idx ON complaints (target_type, status);
+
+CREATE TABLE IF NOT EXISTS complaints_history
+(
+    id           BIGSERIAL PRIMARY KEY,
+    complaint_id BIGINT           NOT NULL,
+    handled_by   BIGINT           NOT NULL,
+    action       complaint_action NOT NULL,
+    comment      TEXT             NULL,
+    created_at   TIMESTAMP        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (complaint_id) REFERENCES complaints (id) ON DELETE CASCADE,
+    FOREIGN KEY (handled_by) REFERENCES users (vk_id) ON DELETE CASCADE
+);
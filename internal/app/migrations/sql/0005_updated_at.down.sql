@@ -0,0 +1,13 @@
+DROP TRIGGER IF EXISTS events_updated_at ON events;
+ALTER TABLE events
+    DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS clubs_updated_at ON clubs;
+ALTER TABLE clubs
+    DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS users_updated_at ON users;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS updated_at;
+
+DROP FUNCTION IF EXISTS set_updated_at();
@@ -0,0 +1,36 @@
+-- updated_at versions the rows behind the profile, club and event payloads; the trigger keeps it
+-- current on every update, including the member counters.
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+DROP TRIGGER IF EXISTS users_updated_at ON users;
+CREATE TRIGGER users_updated_at
+    BEFORE UPDATE
+    ON users
+    FOR EACH ROW
+EXECUTE PROCEDURE set_updated_at();
+
+ALTER TABLE clubs
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+DROP TRIGGER IF EXISTS clubs_updated_at ON clubs;
+CREATE TRIGGER clubs_updated_at
+    BEFORE UPDATE
+    ON clubs
+    FOR EACH ROW
+EXECUTE PROCEDURE set_updated_at();
+
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+DROP TRIGGER IF EXISTS events_updated_at ON events;
+CREATE TRIGGER events_updated_at
+    BEFORE UPDATE
+    ON events
+    FOR EACH ROW
+EXECUTE PROCEDURE set_updated_at();
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    user_id      BIGINT    NOT NULL REFERENCES users (vk_id) ON DELETE CASCADE,
+    key          TEXT      NOT NULL,
+    request_hash TEXT      NOT NULL,
+    -- 0 until the first request with the key has finished.
+    status       INT       NOT NULL DEFAULT 0,
+    content_type TEXT      NOT NULL DEFAULT '',
+    location     TEXT      NOT NULL DEFAULT '',
+    body         BYTEA,
+    created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, key)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_created_at ON idempotency_keys (created_at);
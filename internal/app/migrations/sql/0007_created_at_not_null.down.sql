@@ -0,0 +1,12 @@
+ALTER TABLE events_posts
+    ALTER COLUMN created_at DROP NOT NULL;
+
+ALTER TABLE events
+    ALTER COLUMN created_at DROP NOT NULL;
+
+ALTER TABLE clubs
+    ALTER COLUMN created_at DROP NOT NULL;
+
+ALTER TABLE mini_events
+    ALTER COLUMN created_at DROP NOT NULL,
+    ALTER COLUMN created_at DROP DEFAULT;
@@ -0,0 +1,27 @@
+-- The lists are paged by (created_at, id), which a NULL created_at can't be compared with:
+-- such a row sorts first and never turns up after the first page. Mini events took created_at
+-- from the request, so it could be NULL; rows without one are dated by when they ended.
+UPDATE mini_events
+SET created_at = COALESCE(ended_at, CURRENT_TIMESTAMP)
+WHERE created_at IS NULL;
+ALTER TABLE mini_events
+    ALTER COLUMN created_at SET DEFAULT CURRENT_TIMESTAMP,
+    ALTER COLUMN created_at SET NOT NULL;
+
+UPDATE clubs
+SET created_at = CURRENT_TIMESTAMP
+WHERE created_at IS NULL;
+ALTER TABLE clubs
+    ALTER COLUMN created_at SET NOT NULL;
+
+UPDATE events
+SET created_at = CURRENT_TIMESTAMP
+WHERE created_at IS NULL;
+ALTER TABLE events
+    ALTER COLUMN created_at SET NOT NULL;
+
+UPDATE events_posts
+SET created_at = CURRENT_TIMESTAMP
+WHERE created_at IS NULL;
+ALTER TABLE events_posts
+    ALTER COLUMN created_at SET NOT NULL;
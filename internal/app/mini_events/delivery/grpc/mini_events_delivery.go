@@ -0,0 +1,64 @@
+package delivery
+
+import (
+	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/grpcapi"
+	"github.com/dantedoyl/car-life-api/internal/app/mini_events"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/pkg/carlifepb"
+)
+
+type MiniEventsServer struct {
+	carlifepb.UnimplementedMiniEventsServiceServer
+	miniEventsUcase mini_events.IMiniEventsUsecase
+}
+
+func NewMiniEventsServer(miniEventsUcase mini_events.IMiniEventsUsecase) *MiniEventsServer {
+	return &MiniEventsServer{
+		miniEventsUcase: miniEventsUcase,
+	}
+}
+
+func (ms *MiniEventsServer) GetMiniEvent(ctx context.Context, req *carlifepb.GetMiniEventRequest) (*carlifepb.MiniEvent, error) {
+	event, err := ms.miniEventsUcase.GetMiniEventByID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toMiniEvent(event), nil
+}
+
+func (ms *MiniEventsServer) ListMiniEvents(ctx context.Context, req *carlifepb.ListMiniEventsRequest) (*carlifepb.MiniEventList, error) {
+	page, err := grpcapi.Page(req.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	found, next, err := ms.miniEventsUcase.GetMiniEvents(page, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.MiniEventList{Items: make([]*carlifepb.MiniEvent, 0, len(found))}
+	for _, event := range found {
+		resp.Items = append(resp.Items, toMiniEvent(event))
+	}
+	resp.NextCursor, resp.HasMore = grpcapi.NextCursor(next)
+	return resp, nil
+}
+
+func toMiniEvent(event *models.MiniEvent) *carlifepb.MiniEvent {
+	return &carlifepb.MiniEvent{
+		Id: event.ID,
+		Type: &carlifepb.MiniEventType{
+			Id:          event.Type.ID,
+			Name:        event.Type.PublicName,
+			Description: event.Type.PublicDescription,
+		},
+		Author:      grpcapi.UserCard(event.User),
+		Description: event.Description,
+		CreatedAt:   grpcapi.Timestamp(event.CreatedAt),
+		EndedAt:     grpcapi.Timestamp(event.EndedAt),
+		Latitude:    event.Latitude,
+		Longitude:   event.Longitude,
+	}
+}
@@ -2,9 +2,11 @@ package delivery
 
 import (
 	"encoding/json"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
 	"github.com/dantedoyl/car-life-api/internal/app/middleware"
 	"github.com/dantedoyl/car-life-api/internal/app/mini_events"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"github.com/dantedoyl/car-life-api/internal/app/utils"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/schema"
@@ -24,9 +26,15 @@ func NewMiniEventsHandler(eventsUcase mini_events.IMiniEventsUsecase) *MiniEvent
 }
 
 func (mh *MiniEventsHandler) Configure(r *mux.Router, mw *middleware.Middleware) {
-	r.HandleFunc("/mini_event/create", mw.CheckAuthMiddleware(mh.CreateMiniEvent)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/mini_events/{id:[0-9]+}", mw.CheckAuthMiddleware(mh.GetMiniEventByID)).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/mini_events", mw.CheckAuthMiddleware(mh.GetMiniEvents)).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/mini_event/create", mw.CheckAuthMiddleware(mw.Idempotent(mh.CreateMiniEvent))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/mini_events/{id:[0-9]+}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(mh.GetMiniEventByID))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/mini_events", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(mh.GetMiniEvents))).Methods(http.MethodGet, http.MethodOptions)
+}
+
+func (mh *MiniEventsHandler) ConfigureV2(r *mux.Router, mw *middleware.Middleware) {
+	r.HandleFunc("/mini_events", mw.CheckAuthMiddleware(mw.Idempotent(mh.CreateMiniEventV2))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/mini_events", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(mh.GetMiniEvents))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/mini_events/{id:[0-9]+}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(mh.GetMiniEventByID))).Methods(http.MethodGet, http.MethodOptions)
 }
 
 // CreateMiniEvent godoc
@@ -36,27 +44,62 @@ func (mh *MiniEventsHandler) Configure(r *mux.Router, mw *middleware.Middleware)
 // @Accept       json
 // @Produce      json
 // @Param        body body models.CreateMiniEventRequest true "Event"
+// @Param        Idempotency-Key header string false "Replays the first response to a retry with the same key"
 // @Success      200  {object}  models.MiniEvent
 // @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /mini_event/create [post]
 func (mh *MiniEventsHandler) CreateMiniEvent(w http.ResponseWriter, r *http.Request) {
+	miniEventsData, ok := mh.createMiniEvent(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := json.Marshal(miniEventsData)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// CreateMiniEventV2 is CreateMiniEvent of API v2: it answers 201 with the new mini event in the Location header.
+func (mh *MiniEventsHandler) CreateMiniEventV2(w http.ResponseWriter, r *http.Request) {
+	miniEventsData, ok := mh.createMiniEvent(w, r)
+	if !ok {
+		return
+	}
+
+	utils.WriteCreated(w, r, r.URL.Path+"/"+strconv.FormatUint(miniEventsData.ID, 10), miniEventsData)
+}
+
+// createMiniEvent creates the mini event from the request body. On failure it writes the error and returns false.
+func (mh *MiniEventsHandler) createMiniEvent(w http.ResponseWriter, r *http.Request) (*models.MiniEvent, bool) {
 	defer r.Body.Close()
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
-		return
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+		return nil, false
 	}
 
 	miniEvent := &models.CreateMiniEventRequest{}
 	err := json.NewDecoder(r.Body).Decode(&miniEvent)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't unmarshal data"}))
-		return
+		utils.WriteError(w, r, apperrors.Validation("can't unmarshal data"))
+		return nil, false
+	}
+
+	err = miniEvent.Validate()
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
 	miniEventsData := &models.MiniEvent{
@@ -73,30 +116,13 @@ func (mh *MiniEventsHandler) CreateMiniEvent(w http.ResponseWriter, r *http.Requ
 		Longitude:   miniEvent.Longitude,
 	}
 
-	if miniEventsData.CreatedAt.After(miniEvent.EndedAt) {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "ended time can't be before started time"}))
-		return
-	}
-
 	err = mh.miniEventsUcase.CreateMiniEvent(miniEventsData)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
-	}
-
-	body, err := json.Marshal(miniEventsData)
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
-		return
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-
-	w.WriteHeader(http.StatusOK)
-	w.Write(body)
+	return miniEventsData, true
 }
 
 // GetMiniEvents godoc
@@ -105,10 +131,9 @@ func (mh *MiniEventsHandler) CreateMiniEvent(w http.ResponseWriter, r *http.Requ
 // @Tags         MiniEvents
 // @Accept       json
 // @Produce      json
-// @Param        IdGt query integer false "IdGt"
-// @Param        IdLte query integer false "IdLte"
+// @Param        Cursor query string false "Cursor"
 // @Param        Limit query integer false "Limit"
-// @Success      200  {object}  []models.MiniEvent
+// @Success      200  {object}  pagination.Response{items=[]models.MiniEvent}
 // @Failure      400  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
@@ -119,25 +144,28 @@ func (mh *MiniEventsHandler) GetMiniEvents(w http.ResponseWriter, r *http.Reques
 	decoder.IgnoreUnknownKeys(true)
 	err := decoder.Decode(query, r.URL.Query())
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
+		return
+	}
+
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	events, err := mh.miniEventsUcase.GetMiniEvents(query.IdGt, query.IdLte, query.Limit, query.Query)
+	events, next, err := mh.miniEventsUcase.GetMiniEvents(page, query.Query)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 	if len(events) == 0 {
 		events = []*models.MiniEvent{}
 	}
 
-	body, err := json.Marshal(events)
+	body, err := json.Marshal(pagination.NewResponse(events, next))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -164,15 +192,13 @@ func (mh *MiniEventsHandler) GetMiniEventByID(w http.ResponseWriter, r *http.Req
 
 	event, err := mh.miniEventsUcase.GetMiniEventByID(miniEventID)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	body, err := json.Marshal(event)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
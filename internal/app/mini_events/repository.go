@@ -1,9 +1,12 @@
 package mini_events
 
-import "github.com/dantedoyl/car-life-api/internal/app/models"
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+)
 
 type IMiniEventsRepository interface {
 	InsertMiniEvent(event *models.MiniEvent) error
 	GetMiniEventByID(id int64) (*models.MiniEvent, error)
-	GetMiniEvents(idGt *uint64, idLte *uint64, limit *uint64, query *string) ([]*models.MiniEvent, error)
+	GetMiniEvents(page pagination.Page, query *string) ([]*models.MiniEvent, *pagination.Cursor, error)
 }
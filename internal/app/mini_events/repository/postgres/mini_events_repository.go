@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"github.com/dantedoyl/car-life-api/internal/app/mini_events"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"strconv"
 )
 
@@ -43,6 +44,9 @@ func (mr *MiniEventsRepository) GetMiniEventByID(id int64) (*models.MiniEvent, e
 		`SELECT  id, type_id, user_id, description, created_at, ended_at, latitude, longitude from mini_events
 				WHERE id = $1`, id).Scan(&event.ID, &event.Type.ID, &event.User.VKID, &event.Description, &event.CreatedAt, &event.EndedAt,
 		&event.Latitude, &event.Longitude)
+	if err == sql.ErrNoRows {
+		return nil, mini_events.ErrMiniEventNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +68,7 @@ func (mr *MiniEventsRepository) GetMiniEventByID(id int64) (*models.MiniEvent, e
 	return event, nil
 }
 
-func (mr *MiniEventsRepository) GetMiniEvents(idGt *uint64, idLte *uint64, limit *uint64, query *string) ([]*models.MiniEvent, error) {
+func (mr *MiniEventsRepository) GetMiniEvents(page pagination.Page, query *string) ([]*models.MiniEvent, *pagination.Cursor, error) {
 	var events []*models.MiniEvent
 	ind := 1
 	var values []interface{}
@@ -73,31 +77,26 @@ func (mr *MiniEventsRepository) GetMiniEvents(idGt *uint64, idLte *uint64, limit
 			left join users as u on me.user_id = u.vk_id
 			WHERE me.ended_at>=now() `
 
-	if idGt != nil {
-		q += ` AND me.id > $` + strconv.Itoa(ind)
-		values = append(values, idGt)
-		ind++
-	}
-
-	if idLte != nil {
-		q += ` AND me.id <= $` + strconv.Itoa(ind)
-		values = append(values, idLte)
-		ind++
-	}
-
-	if limit != nil {
-		q += ` LIMIT $` + strconv.Itoa(ind)
-		values = append(values, limit)
+	if page.After != nil {
+		createdAt, err := page.After.Time()
+		if err != nil {
+			return nil, nil, err
+		}
+		q += ` AND (me.created_at, me.id) < ($` + strconv.Itoa(ind) + `, $` + strconv.Itoa(ind+1) + `)`
+		values = append(values, createdAt, page.After.ID)
+		ind += 2
 	}
 
-	q += ` ORDER BY created_at desc`
+	q += ` ORDER BY me.created_at desc, me.id desc LIMIT $` + strconv.Itoa(ind)
+	values = append(values, page.FetchLimit())
 	rows, err := mr.dbConn.Query(q, values...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rows.Close()
 
+	var last, next *pagination.Cursor
 	for rows.Next() {
 		event := &models.MiniEvent{}
 		err = rows.Scan(&event.ID, &event.Type.ID, &event.Type.PublicName, &event.Type.PublicDescription,
@@ -105,9 +104,14 @@ func (mr *MiniEventsRepository) GetMiniEvents(idGt *uint64, idLte *uint64, limit
 			&event.Description, &event.CreatedAt, &event.EndedAt,
 			&event.Latitude, &event.Longitude)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if page.Full(len(events)) {
+			next = last
+			break
 		}
 		events = append(events, event)
+		last = &pagination.Cursor{Key: pagination.TimeKey(event.CreatedAt), ID: event.ID}
 	}
-	return events, nil
+	return events, next, rows.Err()
 }
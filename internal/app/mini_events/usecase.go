@@ -1,11 +1,17 @@
 package mini_events
 
 import (
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+)
+
+var (
+	ErrMiniEventNotFound = apperrors.NotFound("mini event not found")
 )
 
 type IMiniEventsUsecase interface {
 	CreateMiniEvent(event *models.MiniEvent) error
 	GetMiniEventByID(id uint64) (*models.MiniEvent, error)
-	GetMiniEvents(idGt *uint64, idLte *uint64, limit *uint64, query *string) ([]*models.MiniEvent, error)
+	GetMiniEvents(page pagination.Page, query *string) ([]*models.MiniEvent, *pagination.Cursor, error)
 }
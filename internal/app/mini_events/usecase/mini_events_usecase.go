@@ -3,6 +3,7 @@ package usecase
 import (
 	"github.com/dantedoyl/car-life-api/internal/app/mini_events"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 )
 
 type MiniEventsUsecase struct {
@@ -23,6 +24,6 @@ func (mu *MiniEventsUsecase) GetMiniEventByID(id uint64) (*models.MiniEvent, err
 	return mu.miniEventsRepo.GetMiniEventByID(int64(id))
 }
 
-func (mu *MiniEventsUsecase) GetMiniEvents(idGt *uint64, idLte *uint64, limit *uint64, query *string) ([]*models.MiniEvent, error) {
-	return mu.miniEventsRepo.GetMiniEvents(idGt, idLte, limit, query)
+func (mu *MiniEventsUsecase) GetMiniEvents(page pagination.Page, query *string) ([]*models.MiniEvent, *pagination.Cursor, error) {
+	return mu.miniEventsRepo.GetMiniEvents(page, query)
 }
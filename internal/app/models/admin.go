@@ -0,0 +1,55 @@
+package models
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/validation"
+	"time"
+)
+
+type AdminComplaint struct {
+	ID         uint64     `json:"id" binding:"required"`
+	TargetType string     `json:"target_type" binding:"required"`
+	TargetID   uint64     `json:"target_id" binding:"required"`
+	Author     UserCard   `json:"author" binding:"required"`
+	Text       string     `json:"text" binding:"required"`
+	Status     string     `json:"status" binding:"required"`
+	CreatedAt  time.Time  `json:"created_at" binding:"required"`
+	ResolvedBy *UserCard  `json:"resolved_by,omitempty"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	Resolution string     `json:"resolution,omitempty"`
+}
+
+type ComplaintHistoryEntry struct {
+	ID        uint64    `json:"id" binding:"required"`
+	Action    string    `json:"action" binding:"required"`
+	Comment   string    `json:"comment" binding:"required"`
+	HandledBy UserCard  `json:"handled_by" binding:"required"`
+	CreatedAt time.Time `json:"created_at" binding:"required"`
+}
+
+// ComplaintDetails is a complaint together with the object it is about.
+// Target is a Club, Event, EventPost, CarCard or User depending on TargetType,
+// and null if the object has already been deleted.
+type ComplaintDetails struct {
+	Complaint *AdminComplaint          `json:"complaint" binding:"required"`
+	Target    interface{}              `json:"target"`
+	History   []*ComplaintHistoryEntry `json:"history" binding:"required"`
+}
+
+type ResolveComplaintRequest struct {
+	Action  string `json:"action" binding:"required"`
+	Comment string `json:"comment"`
+}
+
+type AdminComplaintQuery struct {
+	TargetType *string
+	Status     *string
+	Cursor     *string
+	Limit      *uint64
+}
+
+func (r *ResolveComplaintRequest) Validate() error {
+	v := validation.New()
+	v.Required("action", r.Action)
+	v.MaxLength("comment", r.Comment, validation.MaxCommentLength)
+	return v.Err()
+}
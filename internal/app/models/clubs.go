@@ -1,5 +1,13 @@
 package models
 
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/validation"
+	"strconv"
+	"strings"
+	"time"
+)
+
 type Club struct {
 	ID                uint64      `json:"id" binding:"required"`
 	Name              string      `json:"name" binding:"required"`
@@ -11,6 +19,7 @@ type Club struct {
 	SubscribersCount int          `json:"subscribers_count" binding:"required"`
 	Owner        UserCard  `json:"owner" binding:"required"`
 	UserStatus string `json:"user_status" binding:"required"`
+	UpdatedAt  time.Time `json:"updated_at" binding:"required"`
 }
 
 type ClubUser struct {
@@ -28,11 +37,15 @@ type ClubCard struct {
 	SubscribersCount int          `json:"subscribers_count" binding:"required"`
 }
 
+type ComplaintQuery struct {
+	Cursor *string
+	Limit  *uint64
+}
+
 type ClubQuery struct {
-	IdGt  *uint64
-	IdLte *uint64
-	Limit *uint64
-	Query *string
+	Cursor *string
+	Limit  *uint64
+	Query  *string
 }
 
 type CreateClubRequest struct {
@@ -42,6 +55,14 @@ type CreateClubRequest struct {
 	Tags        []string `json:"tags" binding:"required"`
 }
 
+// UpdateClubRequest changes the fields that are set and leaves the others as they are.
+// Tags replace the tags of the club, an empty list removes them all.
+type UpdateClubRequest struct {
+	Name        *string  `json:"name"`
+	Description *string  `json:"description"`
+	Tags        []string `json:"tags"`
+}
+
 type Tag struct {
 	ID   uint64 `json:"id" binding:"required"`
 	Name string `json:"name" binding:"required"`
@@ -50,3 +71,37 @@ type Tag struct {
 type ChatLink struct {
 	ChatLink string `json:"chat_link" binding:"required"`
 }
+
+func (r *CreateClubRequest) Validate() error {
+	v := validation.New()
+	v.Required("name", r.Name)
+	v.MaxLength("name", r.Name, validation.MaxNameLength)
+	v.MaxLength("description", r.Description, validation.MaxDescriptionLength)
+	validateTags(v, r.Tags)
+	return v.Err()
+}
+
+func (r *UpdateClubRequest) Validate() error {
+	if r.Name == nil && r.Description == nil && r.Tags == nil {
+		return apperrors.Validation("nothing to update")
+	}
+
+	v := validation.New()
+	if r.Name != nil {
+		v.Required("name", *r.Name)
+		v.MaxLength("name", *r.Name, validation.MaxNameLength)
+	}
+	if r.Description != nil {
+		v.MaxLength("description", *r.Description, validation.MaxDescriptionLength)
+	}
+	validateTags(v, r.Tags)
+	return v.Err()
+}
+
+func validateTags(v *validation.Validator, tags []string) {
+	v.Check(len(tags) <= validation.MaxTagsCount, "tags", "must contain at most "+strconv.Itoa(validation.MaxTagsCount)+" tags")
+	for _, tag := range tags {
+		v.Check(strings.TrimSpace(tag) != "", "tags", "must not contain empty tags")
+		v.MaxLength("tags", tag, validation.MaxNameLength)
+	}
+}
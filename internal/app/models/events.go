@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/validation"
+	"time"
+)
 
 type Event struct {
 	ID          uint64    `json:"id" binding:"required"`
@@ -15,6 +18,7 @@ type Event struct {
 	ParticipantsCount int         `json:"participants_count" binding:"required"`
 	UserStatus string `json:"user_status" binding:"required"`
 	SpectatorsCount int `json:"spectators_count" binding:"required"`
+	UpdatedAt       time.Time `json:"updated_at" binding:"required"`
 }
 
 type EventCard struct {
@@ -29,10 +33,9 @@ type EventCard struct {
 }
 
 type EventQuery struct {
-	IdGt  *uint64
-	IdLte *uint64
-	Limit *uint64
-	Query *string
+	Cursor *string
+	Limit  *uint64
+	Query  *string
 	UpperRightLatitude *float32
 	UpperRightLongitude *float32
 	DownLeftLatitude *float32
@@ -49,3 +52,15 @@ type CreateEventRequest struct {
 	ClubID      uint64    `json:"club_id" binding:"required"`
 	AvatarUrl   string    `json:"avatar" binding:"required"`
 }
+
+func (r *CreateEventRequest) Validate() error {
+	v := validation.New()
+	v.Required("name", r.Name)
+	v.MaxLength("name", r.Name, validation.MaxNameLength)
+	v.MaxLength("description", r.Description, validation.MaxDescriptionLength)
+	v.Future("event_date", r.EventDate)
+	v.Latitude("latitude", r.Latitude)
+	v.Longitude("longitude", r.Longitude)
+	v.Check(r.ClubID != 0, "club_id", "is required")
+	return v.Err()
+}
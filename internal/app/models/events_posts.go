@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/validation"
+	"time"
+)
 
 type EventPost struct {
 	ID          uint64    `json:"id" binding:"required"`
@@ -14,3 +17,10 @@ type EventPost struct {
 type CreatePostRequest struct {
 	Text        string    `json:"text" binding:"required"`
 }
+
+func (r *CreatePostRequest) Validate() error {
+	v := validation.New()
+	v.Required("text", r.Text)
+	v.MaxLength("text", r.Text, validation.MaxPostTextLength)
+	return v.Err()
+}
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/validation"
+	"time"
+)
 
 type MiniEvent struct {
 	ID          uint64    `json:"id" binding:"required"`
@@ -26,3 +29,13 @@ type CreateMiniEventRequest struct {
 	Latitude    float32   `json:"latitude" binding:"required"`
 	Longitude   float32   `json:"longitude" binding:"required"`
 }
+
+func (r *CreateMiniEventRequest) Validate() error {
+	v := validation.New()
+	v.Check(r.TypeID > 0, "type_id", "is required")
+	v.Future("ended_at", r.EndedAt)
+	v.MaxLength("description", r.Description, validation.MaxDescriptionLength)
+	v.Latitude("latitude", r.Latitude)
+	v.Longitude("longitude", r.Longitude)
+	return v.Err()
+}
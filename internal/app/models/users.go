@@ -1,29 +1,73 @@
 package models
 
 import (
+	"github.com/dantedoyl/car-life-api/internal/app/validation"
 	"github.com/google/uuid"
+	"strconv"
 	"time"
 )
 
 type Session struct {
-	Value     string    `json:"value" binding:"required"`
-	UserID    uint64    `json:"user_id" binding:"required"`
-	ExpiresAt time.Time `json:"expires_at" binding:"required"`
+	ID         string    `json:"id" binding:"required"`
+	Value      string    `json:"value" binding:"required"`
+	FamilyID   string    `json:"family_id"`
+	UserID     uint64    `json:"user_id" binding:"required"`
+	ExpiresAt  time.Time `json:"expires_at" binding:"required"`
+	CreatedAt  time.Time `json:"created_at" binding:"required"`
+	LastSeenAt time.Time `json:"last_seen_at" binding:"required"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+}
+
+type SessionInfo struct {
+	ID         string    `json:"id" binding:"required"`
+	CreatedAt  time.Time `json:"created_at" binding:"required"`
+	LastSeenAt time.Time `json:"last_seen_at" binding:"required"`
+	ExpiresAt  time.Time `json:"expires_at" binding:"required"`
+	UserAgent  string    `json:"user_agent" binding:"required"`
+	IP         string    `json:"ip" binding:"required"`
+	Current    bool      `json:"current" binding:"required"`
+}
+
+// RefreshToken is issued together with a session and can be exchanged for a new pair once.
+// All tokens rotated from the same login share FamilyID.
+type RefreshToken struct {
+	Value        string    `json:"value"`
+	FamilyID     string    `json:"family_id"`
+	UserID       uint64    `json:"user_id"`
+	SessionValue string    `json:"session_value"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
+	Used         bool      `json:"used"`
+}
+
+type AuthResponse struct {
+	*Session
+	RefreshToken          string    `json:"refresh_token" binding:"required"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at" binding:"required"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
 }
 
 type SignUpResponse struct {
-	CarID   int64    `json:"car_id" binding:"required"`
-	Session *Session `json:"session" binding:"required"`
+	CarID                 int64     `json:"car_id" binding:"required"`
+	Session               *Session  `json:"session" binding:"required"`
+	RefreshToken          string    `json:"refresh_token" binding:"required"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at" binding:"required"`
 }
 
 type User struct {
-	VKID        uint64   `json:"vkid" binding:"required"`
-	Name        string   `json:"name" binding:"required"`
-	Surname     string   `json:"surname" binding:"required"`
-	AvatarUrl   string   `json:"avatar_url" binding:"required"`
-	Tags        []string `json:"tags" binding:"required"`
-	Description string   `json:"description" binding:"required"`
-	CarID       int64    `json:"-"`
+	VKID        uint64    `json:"vkid" binding:"required"`
+	Name        string    `json:"name" binding:"required"`
+	Surname     string    `json:"surname" binding:"required"`
+	AvatarUrl   string    `json:"avatar_url" binding:"required"`
+	Tags        []string  `json:"tags" binding:"required"`
+	Description string    `json:"description" binding:"required"`
+	CarID       int64     `json:"-"`
+	Banned      bool      `json:"-"`
+	UpdatedAt   time.Time `json:"updated_at" binding:"required"`
 }
 
 type UserCard struct {
@@ -59,13 +103,13 @@ type CarRequest struct {
 }
 
 type SignUpRequest struct {
-	VKID        uint64
-	Name        string
-	Surname     string
-	AvatarUrl   string
-	Garage      []CarRequest
-	Tags        []string
-	Description string
+	LaunchParams string
+	Name         string
+	Surname      string
+	AvatarUrl    string
+	Garage       []CarRequest
+	Tags         []string
+	Description  string
 }
 
 type UpdateRequest struct {
@@ -74,14 +118,31 @@ type UpdateRequest struct {
 }
 
 type LoginRequest struct {
-	VKID uint64
+	LaunchParams string
 }
 
-func CreateSession(userID uint64) *Session {
+func CreateSession(userID uint64, familyID string, ttl time.Duration) *Session {
+	now := time.Now()
 	return &Session{
-		Value:     uuid.New().String(),
-		UserID:    userID,
-		ExpiresAt: time.Now().Add(10 * time.Hour),
+		ID:         uuid.New().String(),
+		Value:      uuid.New().String(),
+		FamilyID:   familyID,
+		UserID:     userID,
+		ExpiresAt:  now.Add(ttl),
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+}
+
+func CreateRefreshToken(session *Session, ttl time.Duration) *RefreshToken {
+	now := time.Now()
+	return &RefreshToken{
+		Value:        uuid.New().String(),
+		FamilyID:     session.FamilyID,
+		UserID:       session.UserID,
+		SessionValue: session.Value,
+		ExpiresAt:    now.Add(ttl),
+		CreatedAt:    now,
 	}
 }
 
@@ -93,4 +154,58 @@ type Complaint struct {
 
 type ComplaintReq struct {
 	Text string
-}
\ No newline at end of file
+}
+
+type ComplaintCard struct {
+	ID         uint64 `json:"id" binding:"required"`
+	TargetType string `json:"target_type" binding:"required"`
+	TargetID   uint64 `json:"target_id" binding:"required"`
+	UserID     uint64 `json:"user_id" binding:"required"`
+	Text       string `json:"text" binding:"required"`
+}
+
+func (r *ComplaintReq) Validate() error {
+	v := validation.New()
+	v.MaxLength("text", r.Text, validation.MaxCommentLength)
+	return v.Err()
+}
+
+func (r *CarRequest) Validate() error {
+	v := validation.New()
+	r.validate(v, "")
+	return v.Err()
+}
+
+func (r *CarRequest) validate(v *validation.Validator, prefix string) {
+	v.Required(prefix+"brand", r.Brand)
+	v.MaxLength(prefix+"brand", r.Brand, validation.MaxNameLength)
+	v.Required(prefix+"model", r.Model)
+	v.MaxLength(prefix+"model", r.Model, validation.MaxNameLength)
+	v.MaxLength(prefix+"name", r.Name, validation.MaxNameLength)
+	v.MaxLength(prefix+"body", r.Body, validation.MaxNameLength)
+	v.MaxLength(prefix+"engine", r.Engine, validation.MaxNameLength)
+	v.MaxLength(prefix+"horse_power", r.HorsePower, validation.MaxNameLength)
+	v.MaxLength(prefix+"description", r.Description, validation.MaxDescriptionLength)
+	v.NotFuture(prefix+"date", r.Date)
+}
+
+func (r *SignUpRequest) Validate() error {
+	v := validation.New()
+	v.Required("name", r.Name)
+	v.MaxLength("name", r.Name, validation.MaxNameLength)
+	v.Required("surname", r.Surname)
+	v.MaxLength("surname", r.Surname, validation.MaxNameLength)
+	v.MaxLength("description", r.Description, validation.MaxDescriptionLength)
+	validateTags(v, r.Tags)
+	for i := range r.Garage {
+		r.Garage[i].validate(v, "garage["+strconv.Itoa(i)+"].")
+	}
+	return v.Err()
+}
+
+func (r *UpdateRequest) Validate() error {
+	v := validation.New()
+	v.MaxLength("description", r.Description, validation.MaxDescriptionLength)
+	validateTags(v, r.Tags)
+	return v.Err()
+}
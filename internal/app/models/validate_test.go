@@ -0,0 +1,133 @@
+package models
+
+import (
+	"errors"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/validation"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type validatable interface {
+	Validate() error
+}
+
+func longString(n int) string {
+	return strings.Repeat("a", n)
+}
+
+func manyTags(n int) []string {
+	tags := make([]string, n)
+	for i := range tags {
+		tags[i] = "tag"
+	}
+	return tags
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+// invalidFields returns the fields of a validation error in the order they were reported.
+func invalidFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	var appErr *apperrors.Error
+	if !errors.As(err, &appErr) || appErr.Code != apperrors.CodeValidation {
+		t.Fatalf("Validate() = %v, want a validation error", err)
+	}
+	fields := make([]string, 0, len(appErr.Fields))
+	for _, field := range appErr.Fields {
+		fields = append(fields, field.Field)
+	}
+	return fields
+}
+
+func TestValidate(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour)
+	past := time.Now().Add(-24 * time.Hour)
+	validCar := CarRequest{Brand: "Lada", Model: "2107", Date: past}
+
+	tests := []struct {
+		name string
+		req  validatable
+		want []string
+	}{
+		{"club", &CreateClubRequest{Name: "Club", Tags: []string{"jdm"}}, nil},
+		{"club without name", &CreateClubRequest{Name: " "}, []string{"name"}},
+		{"club name at the limit", &CreateClubRequest{Name: longString(validation.MaxNameLength)}, nil},
+		{"club name too long", &CreateClubRequest{Name: longString(validation.MaxNameLength + 1)}, []string{"name"}},
+		{"club description too long", &CreateClubRequest{Name: "Club", Description: longString(validation.MaxDescriptionLength + 1)}, []string{"description"}},
+		{"club tags at the limit", &CreateClubRequest{Name: "Club", Tags: manyTags(validation.MaxTagsCount)}, nil},
+		{"club too many tags", &CreateClubRequest{Name: "Club", Tags: manyTags(validation.MaxTagsCount + 1)}, []string{"tags"}},
+		{"club empty tag", &CreateClubRequest{Name: "Club", Tags: []string{"jdm", " "}}, []string{"tags"}},
+		{"club tag too long", &CreateClubRequest{Name: "Club", Tags: []string{longString(validation.MaxNameLength + 1)}}, []string{"tags"}},
+		{"club everything wrong", &CreateClubRequest{Description: longString(validation.MaxDescriptionLength + 1), Tags: []string{""}}, []string{"name", "description", "tags"}},
+
+		{"club update name", &UpdateClubRequest{Name: strPtr("Club")}, nil},
+		{"club update clears tags", &UpdateClubRequest{Tags: []string{}}, nil},
+		{"club update blank name", &UpdateClubRequest{Name: strPtr("")}, []string{"name"}},
+		{"club update description too long", &UpdateClubRequest{Description: strPtr(longString(validation.MaxDescriptionLength + 1))}, []string{"description"}},
+		{"club update too many tags", &UpdateClubRequest{Tags: manyTags(validation.MaxTagsCount + 1)}, []string{"tags"}},
+
+		{"event", &CreateEventRequest{Name: "Meet", EventDate: future, Latitude: 55.75, Longitude: 37.62, ClubID: 1}, nil},
+		{"event in the past", &CreateEventRequest{Name: "Meet", EventDate: past, ClubID: 1}, []string{"event_date"}},
+		{"event out of the map", &CreateEventRequest{Name: "Meet", EventDate: future, Latitude: 91, Longitude: -181, ClubID: 1}, []string{"latitude", "longitude"}},
+		{"event without name and club", &CreateEventRequest{EventDate: future}, []string{"name", "club_id"}},
+		{"event name too long", &CreateEventRequest{Name: longString(validation.MaxNameLength + 1), EventDate: future, ClubID: 1}, []string{"name"}},
+
+		{"mini event", &CreateMiniEventRequest{TypeID: 1, EndedAt: future, Latitude: -33.9, Longitude: 151.2}, nil},
+		{"mini event already ended", &CreateMiniEventRequest{TypeID: 1, EndedAt: past}, []string{"ended_at"}},
+		{"mini event without type", &CreateMiniEventRequest{EndedAt: future}, []string{"type_id"}},
+		{"mini event description too long", &CreateMiniEventRequest{TypeID: 1, EndedAt: future, Description: longString(validation.MaxDescriptionLength + 1)}, []string{"description"}},
+
+		{"post", &CreatePostRequest{Text: "Hello"}, nil},
+		{"post without text", &CreatePostRequest{}, []string{"text"}},
+		{"post text at the limit", &CreatePostRequest{Text: longString(validation.MaxPostTextLength)}, nil},
+		{"post text too long", &CreatePostRequest{Text: longString(validation.MaxPostTextLength + 1)}, []string{"text"}},
+
+		{"car", &validCar, nil},
+		{"car without brand and model", &CarRequest{Date: past}, []string{"brand", "model"}},
+		{"car from the future", &CarRequest{Brand: "Lada", Model: "2107", Date: future}, []string{"date"}},
+		{"car fields too long", &CarRequest{Brand: "Lada", Model: "2107", Name: longString(validation.MaxNameLength + 1), Description: longString(validation.MaxDescriptionLength + 1)}, []string{"name", "description"}},
+
+		{"sign up", &SignUpRequest{Name: "Ivan", Surname: "Petrov", Garage: []CarRequest{validCar}, Tags: []string{"drift"}}, nil},
+		{"sign up without name", &SignUpRequest{Surname: "Petrov"}, []string{"name"}},
+		{"sign up reports garage fields by index", &SignUpRequest{Name: "Ivan", Surname: "Petrov", Garage: []CarRequest{validCar, {Brand: "BMW", Date: future}}}, []string{"garage[1].model", "garage[1].date"}},
+		{"sign up too many tags", &SignUpRequest{Name: "Ivan", Surname: "Petrov", Tags: manyTags(validation.MaxTagsCount + 1)}, []string{"tags"}},
+
+		{"profile update", &UpdateRequest{Description: "Drifter", Tags: []string{"drift"}}, nil},
+		{"profile update description too long", &UpdateRequest{Description: longString(validation.MaxDescriptionLength + 1)}, []string{"description"}},
+
+		{"complaint", &ComplaintReq{Text: "Spam"}, nil},
+		{"complaint without text", &ComplaintReq{}, nil},
+		{"complaint text too long", &ComplaintReq{Text: longString(validation.MaxCommentLength + 1)}, []string{"text"}},
+
+		{"resolution", &ResolveComplaintRequest{Action: "dismiss"}, nil},
+		{"resolution without action", &ResolveComplaintRequest{}, []string{"action"}},
+		{"resolution comment too long", &ResolveComplaintRequest{Action: "hide", Comment: longString(validation.MaxCommentLength + 1)}, []string{"comment"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := invalidFields(t, tt.req.Validate())
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("invalid fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateClubRequestNothingToUpdate(t *testing.T) {
+	err := (&UpdateClubRequest{}).Validate()
+	if !errors.Is(err, apperrors.ErrValidation) {
+		t.Fatalf("Validate() = %v, want a validation error", err)
+	}
+	if fields := invalidFields(t, err); len(fields) != 0 {
+		t.Fatalf("invalid fields = %v, want none", fields)
+	}
+}
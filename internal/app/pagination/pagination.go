@@ -0,0 +1,118 @@
+// Package pagination implements keyset pagination: a page continues strictly after the
+// (sort key, id) of the last item of the previous page, which the client gets back as an opaque cursor.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var ErrInvalidCursor = apperrors.InvalidFields([]apperrors.FieldError{{Field: "cursor", Error: "is not a valid cursor"}})
+
+// Cursor identifies the last item of a page. Key is the item's sort key in the form
+// produced by TimeKey or as is for text keys; lists sorted by id alone leave it empty.
+type Cursor struct {
+	Key string `json:"k,omitempty"`
+	ID  uint64 `json:"id"`
+}
+
+// Encode turns the cursor into the token handed out as next_cursor.
+func (c *Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Time parses a key produced by TimeKey.
+func (c *Cursor) Time() (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, c.Key)
+	if err != nil {
+		return time.Time{}, ErrInvalidCursor
+	}
+	return t, nil
+}
+
+func Decode(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	c := &Cursor{}
+	err = json.Unmarshal(data, c)
+	if err != nil || c.ID == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return c, nil
+}
+
+func TimeKey(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// Page is the requested window of a list: at most Limit items after After, or from the start if After is nil.
+type Page struct {
+	Limit uint64
+	After *Cursor
+}
+
+// NewPage builds a Page from the Cursor and Limit query parameters, both optional.
+func NewPage(cursor *string, limit *uint64) (Page, error) {
+	page := Page{Limit: DefaultLimit}
+
+	if limit != nil {
+		if *limit == 0 || *limit > MaxLimit {
+			return Page{}, apperrors.InvalidFields([]apperrors.FieldError{{
+				Field: "limit",
+				Error: "must be between 1 and " + strconv.Itoa(MaxLimit),
+			}})
+		}
+		page.Limit = *limit
+	}
+
+	if cursor != nil && *cursor != "" {
+		after, err := Decode(*cursor)
+		if err != nil {
+			return Page{}, err
+		}
+		page.After = after
+	}
+
+	return page, nil
+}
+
+// FetchLimit is the number of rows a repository should select: one more than the page holds,
+// so that the extra row tells whether there is a next page.
+func (p Page) FetchLimit() uint64 {
+	return p.Limit + 1
+}
+
+// Full reports whether n items already fill the page, i.e. the row being read belongs to the next one.
+func (p Page) Full(n int) bool {
+	return uint64(n) >= p.Limit
+}
+
+// Response is the envelope of every list endpoint.
+type Response struct {
+	Items      interface{} `json:"items"`
+	NextCursor string      `json:"next_cursor"`
+	HasMore    bool        `json:"has_more"`
+}
+
+// NewResponse wraps items, which must be a non-nil slice, with the cursor of the next page (nil on the last page).
+func NewResponse(items interface{}, next *Cursor) Response {
+	resp := Response{Items: items}
+	if next != nil {
+		resp.NextCursor = next.Encode()
+		resp.HasMore = true
+	}
+	return resp
+}
@@ -0,0 +1,189 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func uintPtr(n uint64) *uint64 {
+	return &n
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func rawToken(data string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(data))
+}
+
+func TestNewPage(t *testing.T) {
+	cursor := &Cursor{Key: "2022-05-14T12:00:00Z", ID: 42}
+
+	tests := []struct {
+		name      string
+		cursor    *string
+		limit     *uint64
+		want      Page
+		wantField string
+	}{
+		{"defaults", nil, nil, Page{Limit: DefaultLimit}, ""},
+		{"empty cursor", strPtr(""), nil, Page{Limit: DefaultLimit}, ""},
+		{"smallest limit", nil, uintPtr(1), Page{Limit: 1}, ""},
+		{"largest limit", nil, uintPtr(MaxLimit), Page{Limit: MaxLimit}, ""},
+		{"zero limit", nil, uintPtr(0), Page{}, "limit"},
+		{"limit over the maximum", nil, uintPtr(MaxLimit + 1), Page{}, "limit"},
+		{"cursor", strPtr(cursor.Encode()), uintPtr(5), Page{Limit: 5, After: cursor}, ""},
+		{"malformed cursor", strPtr("%%%"), nil, Page{}, "cursor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := NewPage(tt.cursor, tt.limit)
+			if tt.wantField != "" {
+				var appErr *apperrors.Error
+				if !errors.As(err, &appErr) || len(appErr.Fields) != 1 || appErr.Fields[0].Field != tt.wantField {
+					t.Fatalf("NewPage() error = %v, want a validation error of %s", err, tt.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewPage() error = %v", err)
+			}
+			if !reflect.DeepEqual(page, tt.want) {
+				t.Fatalf("NewPage() = %+v, want %+v", page, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  *Cursor
+	}{
+		{"time key", (&Cursor{Key: "2022-05-14T12:00:00Z", ID: 7}).Encode(), &Cursor{Key: "2022-05-14T12:00:00Z", ID: 7}},
+		{"id only", (&Cursor{ID: 7}).Encode(), &Cursor{ID: 7}},
+		{"malformed base64", "not base64!", nil},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`{"id":7}`)), nil},
+		{"bad JSON", rawToken(`{"id":`), nil},
+		{"not an object", rawToken(`[7]`), nil},
+		{"wrong id type", rawToken(`{"id":"7"}`), nil},
+		{"no id", rawToken(`{"k":"2022-05-14T12:00:00Z"}`), nil},
+		{"zero id", rawToken(`{"id":0}`), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.token)
+			if tt.want == nil {
+				if err != ErrInvalidCursor {
+					t.Fatalf("Decode() = %+v, %v, want %v", got, err, ErrInvalidCursor)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Decode() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCursorTime(t *testing.T) {
+	at := time.Date(2022, time.May, 14, 12, 0, 0, 123456789, time.FixedZone("MSK", 3*60*60))
+
+	got, err := (&Cursor{Key: TimeKey(at), ID: 1}).Time()
+	if err != nil {
+		t.Fatalf("Time() error = %v", err)
+	}
+	if !got.Equal(at) {
+		t.Fatalf("Time() = %v, want %v", got, at)
+	}
+
+	// A cursor of a list sorted by text or by id alone is the wrong kind for a list sorted by time.
+	for _, key := range []string{"", "Club name", "1652529600"} {
+		if _, err := (&Cursor{Key: key, ID: 1}).Time(); err != ErrInvalidCursor {
+			t.Errorf("Time() of key %q error = %v, want %v", key, err, ErrInvalidCursor)
+		}
+	}
+}
+
+func TestPageFull(t *testing.T) {
+	page := Page{Limit: 3}
+	if page.FetchLimit() != 4 {
+		t.Fatalf("FetchLimit() = %d, want 4", page.FetchLimit())
+	}
+
+	tests := []struct {
+		read int
+		want bool
+	}{
+		{0, false},
+		{2, false},
+		{3, true},
+		{4, true},
+	}
+	for _, tt := range tests {
+		if got := page.Full(tt.read); got != tt.want {
+			t.Errorf("Full(%d) = %v, want %v", tt.read, got, tt.want)
+		}
+	}
+}
+
+// TestPageHasMore reads rows the way the repositories do: up to FetchLimit rows, stopping
+// with a next cursor at the first row that doesn't fit.
+func TestPageHasMore(t *testing.T) {
+	const limit = 3
+
+	tests := []struct {
+		name     string
+		rows     int
+		wantRead int
+		wantNext bool
+	}{
+		{"empty", 0, 0, false},
+		{"short page", limit - 1, limit - 1, false},
+		{"exactly a page", limit, limit, false},
+		{"one row more", limit + 1, limit, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := Page{Limit: limit}
+			rows := tt.rows
+			if uint64(rows) > page.FetchLimit() {
+				rows = int(page.FetchLimit())
+			}
+
+			var items []uint64
+			var next, last *Cursor
+			for id := uint64(1); id <= uint64(rows); id++ {
+				if page.Full(len(items)) {
+					next = last
+					break
+				}
+				items = append(items, id)
+				last = &Cursor{ID: id}
+			}
+
+			resp := NewResponse(items, next)
+			if len(items) != tt.wantRead || resp.HasMore != tt.wantNext {
+				t.Fatalf("read %d items, has_more = %v, want %d and %v", len(items), resp.HasMore, tt.wantRead, tt.wantNext)
+			}
+			if tt.wantNext && resp.NextCursor != (&Cursor{ID: limit}).Encode() {
+				t.Fatalf("next_cursor = %q, want the cursor of the last item", resp.NextCursor)
+			}
+			if !tt.wantNext && resp.NextCursor != "" {
+				t.Fatalf("next_cursor = %q, want none", resp.NextCursor)
+			}
+		})
+	}
+}
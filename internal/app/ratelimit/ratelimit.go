@@ -0,0 +1,106 @@
+// Package ratelimit throttles the expensive or abusable requests with token buckets,
+// one per client and route class.
+package ratelimit
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+// Class groups the routes that share a bucket, so that a client can't get around
+// a limit by spreading the requests over several endpoints of the same kind.
+type Class string
+
+const (
+	// ClassAuth covers sign up, login and session refresh, which are limited per IP.
+	ClassAuth Class = "auth"
+	// ClassComplaints covers the complaints about users, cars, clubs, events and posts.
+	ClassComplaints Class = "complaints"
+	// ClassPosts covers creating event posts.
+	ClassPosts Class = "posts"
+	// ClassUploads covers avatar and attachment uploads, which cost image processing.
+	ClassUploads Class = "uploads"
+	// ClassParticipation covers joining clubs and events, which messages the organizers on VK.
+	ClassParticipation Class = "participation"
+)
+
+// Limit is a token bucket that holds up to Burst tokens and refills Rate tokens a second.
+// Every request takes one token.
+type Limit struct {
+	Rate  float64
+	Burst float64
+}
+
+// NewLimit allows requests requests per period on average and up to burst at once.
+// A zero requests or burst disables the limit.
+func NewLimit(requests int, per time.Duration, burst int) Limit {
+	if requests <= 0 || per <= 0 || burst <= 0 {
+		return Limit{}
+	}
+	return Limit{Rate: float64(requests) / per.Seconds(), Burst: float64(burst)}
+}
+
+func (l Limit) Enabled() bool {
+	return l.Rate > 0 && l.Burst >= 1
+}
+
+// Take refills a bucket that had tokens left at last and takes a token from it at now.
+// It returns the tokens left and, if the bucket was empty, how long until a token is available;
+// an empty bucket is returned refilled but untaken.
+func (l Limit) Take(tokens float64, last time.Time, now time.Time) (float64, time.Duration) {
+	if elapsed := now.Sub(last).Seconds(); elapsed > 0 {
+		tokens = math.Min(l.Burst, tokens+elapsed*l.Rate)
+	}
+	if tokens < 1 {
+		return tokens, time.Duration((1 - tokens) / l.Rate * float64(time.Second))
+	}
+	return tokens - 1, 0
+}
+
+// FullAt is when a bucket with tokens left at last is full again and may be forgotten.
+func (l Limit) FullAt(tokens float64, last time.Time) time.Time {
+	return last.Add(time.Duration((l.Burst - tokens) / l.Rate * float64(time.Second)))
+}
+
+type Limits map[Class]Limit
+
+// Store keeps the buckets. Take takes a token from the bucket of key, creating a full one
+// if there is none, and returns zero on success or how long to wait for the next token.
+type Store interface {
+	Take(key string, limit Limit) (time.Duration, error)
+}
+
+type fallbackStore struct {
+	primary  Store
+	fallback Store
+	log      *logger.Logger
+	degraded int32
+}
+
+// NewFallbackStore takes the tokens from primary and switches to fallback for the requests
+// that primary fails, so that an outage of the shared store doesn't fail the requests.
+// The limits are then counted per instance until primary answers again.
+func NewFallbackStore(primary Store, fallback Store, log *logger.Logger) Store {
+	return &fallbackStore{
+		primary:  primary,
+		fallback: fallback,
+		log:      log,
+	}
+}
+
+func (fs *fallbackStore) Take(key string, limit Limit) (time.Duration, error) {
+	wait, err := fs.primary.Take(key, limit)
+	if err == nil {
+		if atomic.CompareAndSwapInt32(&fs.degraded, 1, 0) {
+			fs.log.Info("rate limit store is back")
+		}
+		return wait, nil
+	}
+
+	if atomic.CompareAndSwapInt32(&fs.degraded, 0, 1) {
+		fs.log.Error("rate limit store failed, counting in memory", "error", err)
+	}
+	return fs.fallback.Take(key, limit)
+}
@@ -0,0 +1,82 @@
+package ratelimit_memory
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/ratelimit"
+	"sync"
+	"time"
+)
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	fullAt time.Time
+}
+
+// MemoryStore keeps the buckets in the process memory, so every instance counts on its own.
+type MemoryStore struct {
+	mtx     sync.Mutex
+	buckets map[string]*bucket
+	done    chan struct{}
+}
+
+// NewMemoryStore starts a janitor that forgets the refilled buckets every evictInterval.
+func NewMemoryStore(evictInterval time.Duration) *MemoryStore {
+	ms := &MemoryStore{
+		buckets: make(map[string]*bucket),
+		done:    make(chan struct{}),
+	}
+
+	go ms.evictLoop(evictInterval)
+
+	return ms
+}
+
+func (ms *MemoryStore) Take(key string, limit ratelimit.Limit) (time.Duration, error) {
+	now := time.Now()
+
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+
+	b, ok := ms.buckets[key]
+	if !ok {
+		b = &bucket{tokens: limit.Burst, last: now}
+		ms.buckets[key] = b
+	}
+
+	var wait time.Duration
+	b.tokens, wait = limit.Take(b.tokens, b.last, now)
+	b.last = now
+	b.fullAt = limit.FullAt(b.tokens, now)
+
+	return wait, nil
+}
+
+// Stop stops the janitor.
+func (ms *MemoryStore) Stop() {
+	close(ms.done)
+}
+
+func (ms *MemoryStore) evictLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ms.evict(time.Now())
+		case <-ms.done:
+			return
+		}
+	}
+}
+
+func (ms *MemoryStore) evict(now time.Time) {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+
+	for key, b := range ms.buckets {
+		if b.fullAt.Before(now) {
+			delete(ms.buckets, key)
+		}
+	}
+}
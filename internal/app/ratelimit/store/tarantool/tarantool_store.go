@@ -0,0 +1,48 @@
+package ratelimit_tarantool
+
+import (
+	"fmt"
+	"github.com/dantedoyl/car-life-api/internal/app/metrics"
+	"github.com/dantedoyl/car-life-api/internal/app/ratelimit"
+	"github.com/tarantool/go-tarantool"
+	"time"
+)
+
+// TarantoolStore keeps the buckets in the rate_limits space, shared by every instance.
+// take_token refills and takes in one call, so concurrent requests can't overdraw a bucket.
+type TarantoolStore struct {
+	conn *tarantool.Connection
+}
+
+func NewTarantoolStore(conn *tarantool.Connection) ratelimit.Store {
+	return &TarantoolStore{
+		conn: conn,
+	}
+}
+
+func (ts *TarantoolStore) Take(key string, limit ratelimit.Limit) (time.Duration, error) {
+	start := time.Now()
+	resp, err := ts.conn.Call17("take_token", []interface{}{key, limit.Rate, limit.Burst})
+	metrics.ObserveSince(metrics.TarantoolCallDuration.WithLabelValues("take_token"), start)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(resp.Data) == 0 {
+		return 0, fmt.Errorf("take_token returned nothing")
+	}
+
+	var seconds float64
+	switch wait := resp.Data[0].(type) {
+	case float64:
+		seconds = wait
+	case int64:
+		seconds = float64(wait)
+	case uint64:
+		seconds = float64(wait)
+	default:
+		return 0, fmt.Errorf("cannot cast data")
+	}
+
+	return time.Duration(seconds * float64(time.Second)), nil
+}
@@ -0,0 +1,274 @@
+// Package seed fills a fresh database with demo users, cars, clubs, events and mini events.
+// Everything goes through the usecases, so the counters and uploaded images end up
+// exactly as if the data had been created through the API.
+package seed
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	clubs "github.com/dantedoyl/car-life-api/internal/app/clubs"
+	"github.com/dantedoyl/car-life-api/internal/app/events"
+	"github.com/dantedoyl/car-life-api/internal/app/mini_events"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/users"
+	"image"
+	"image/color"
+	"image/png"
+	"mime/multipart"
+	"time"
+)
+
+// ErrAlreadySeeded is returned when the demo users are already in the database.
+var ErrAlreadySeeded = apperrors.Conflict("demo data is already loaded")
+
+// Demo users get ids far above the real VK ids so they never clash with signed up users.
+const demoUserIDBase = 9000000000
+
+const demoAvatarUrl = "https://vk.com/images/camera_200.png"
+
+type demoUser struct {
+	name, surname, description string
+	tags                       []string
+	car                        models.CarCard
+	color                      color.RGBA
+}
+
+type demoClub struct {
+	owner       int
+	name        string
+	description string
+	tags        []string
+	color       color.RGBA
+}
+
+type demoEvent struct {
+	club, creator int
+	name          string
+	description   string
+	in            time.Duration
+	latitude      float32
+	longitude     float32
+	color         color.RGBA
+}
+
+var demoUsers = []demoUser{
+	{
+		name: "Алексей", surname: "Смирнов", description: "Езжу на японцах с 2008 года",
+		tags:  []string{"jdm", "drift"},
+		car:   models.CarCard{Brand: "Nissan", Model: "Silvia S15", Name: "Серебрянка", Body: "coupe", Engine: "SR20DET", HorsePower: "250", Description: "Дрифт-проект"},
+		color: color.RGBA{R: 0xb0, G: 0xb8, B: 0xc0, A: 0xff},
+	},
+	{
+		name: "Мария", surname: "Иванова", description: "Люблю старые немецкие машины",
+		tags:  []string{"vintage", "Олдтаймер"},
+		car:   models.CarCard{Brand: "Mercedes-Benz", Model: "W123", Body: "sedan", Engine: "OM617", HorsePower: "88", Description: "Дизель, пробег миллион"},
+		color: color.RGBA{R: 0xe8, G: 0xdc, B: 0xb0, A: 0xff},
+	},
+	{
+		name: "Дмитрий", surname: "Кузнецов", description: "Бездорожье и путешествия",
+		tags:  []string{"4x4", "Offroad", "Traveler"},
+		car:   models.CarCard{Brand: "Toyota", Model: "Land Cruiser 80", Body: "suv", Engine: "1HD-T", HorsePower: "165", Description: "Экспедиционная подготовка"},
+		color: color.RGBA{R: 0x4a, G: 0x6b, B: 0x3a, A: 0xff},
+	},
+	{
+		name: "Екатерина", surname: "Соколова", description: "Трек-дни по выходным",
+		tags:  []string{"racing", "cars&coffee"},
+		car:   models.CarCard{Brand: "BMW", Model: "M3 E46", Body: "coupe", Engine: "S54", HorsePower: "343", Description: "Полу-трековая"},
+		color: color.RGBA{R: 0x1f, G: 0x4e, B: 0xa8, A: 0xff},
+	},
+}
+
+var demoClubs = []demoClub{
+	{owner: 0, name: "Sideways Moscow", description: "Дрифт-тренировки и покатушки по ночной Москве", tags: []string{"jdm", "drift", "local"}, color: color.RGBA{R: 0xd0, G: 0x30, B: 0x30, A: 0xff}},
+	{owner: 1, name: "Klassik Garage", description: "Клуб владельцев классических автомобилей", tags: []string{"vintage", "Олдтаймер", "Янгтаймер"}, color: color.RGBA{R: 0x8a, G: 0x6a, B: 0x3a, A: 0xff}},
+	{owner: 2, name: "Грязь и Тайга", description: "Выезды на бездорожье каждые выходные", tags: []string{"4x4", "Offroad"}, color: color.RGBA{R: 0x3a, G: 0x5a, B: 0x2a, A: 0xff}},
+}
+
+var demoEvents = []demoEvent{
+	{club: 0, creator: 0, name: "Ночная тренировка", description: "Площадка в Нагатино, заезды с 22:00", in: 72 * time.Hour, latitude: 55.682, longitude: 37.676, color: color.RGBA{R: 0x20, G: 0x20, B: 0x40, A: 0xff}},
+	{club: 1, creator: 1, name: "Утренний кофе с классикой", description: "Встреча у ВДНХ, приезжайте на своих ретро", in: 120 * time.Hour, latitude: 55.826, longitude: 37.637, color: color.RGBA{R: 0xc0, G: 0x90, B: 0x50, A: 0xff}},
+	{club: 2, creator: 2, name: "Покатушка на Истру", description: "Лёгкий маршрут, подходит новичкам", in: 240 * time.Hour, latitude: 55.914, longitude: 36.860, color: color.RGBA{R: 0x50, G: 0x70, B: 0x30, A: 0xff}},
+}
+
+type Seeder struct {
+	usersUcase      users.IUsersUsecase
+	clubsUcase      clubs.IClubsUsecase
+	eventsUcase     events.IEventsUsecase
+	miniEventsUcase mini_events.IMiniEventsUsecase
+}
+
+func NewSeeder(usersUcase users.IUsersUsecase, clubsUcase clubs.IClubsUsecase, eventsUcase events.IEventsUsecase,
+	miniEventsUcase mini_events.IMiniEventsUsecase) *Seeder {
+	return &Seeder{
+		usersUcase:      usersUcase,
+		clubsUcase:      clubsUcase,
+		eventsUcase:     eventsUcase,
+		miniEventsUcase: miniEventsUcase,
+	}
+}
+
+// Run loads the demo data. It must be run from the directory that holds img/.
+func (s *Seeder) Run() error {
+	_, err := s.usersUcase.GetByID(demoUserIDBase)
+	if err == nil {
+		return ErrAlreadySeeded
+	}
+	if !errors.Is(err, users.ErrUserNotFound) {
+		return err
+	}
+
+	userIDs := make([]uint64, len(demoUsers))
+	for i, u := range demoUsers {
+		userIDs[i] = demoUserIDBase + uint64(i)
+		car := u.car
+		car.Date = time.Date(2000+i*3, time.June, 1, 0, 0, 0, 0, time.UTC)
+		user, err := s.usersUcase.Create(&models.User{
+			VKID:        userIDs[i],
+			Name:        u.name,
+			Surname:     u.surname,
+			AvatarUrl:   demoAvatarUrl,
+			Tags:        u.tags,
+			Description: u.description,
+		}, &car)
+		if err != nil {
+			return fmt.Errorf("user %s %s: %w", u.name, u.surname, err)
+		}
+
+		photo, err := demoPhoto("car", u.color)
+		if err != nil {
+			return err
+		}
+		_, err = s.usersUcase.UpdateAvatar(uint64(user.CarID), photo)
+		if err != nil {
+			return fmt.Errorf("car photo of %s %s: %w", u.name, u.surname, err)
+		}
+	}
+
+	clubIDs := make([]uint64, len(demoClubs))
+	for i, c := range demoClubs {
+		club := &models.Club{
+			Name:        c.name,
+			Description: c.description,
+			Tags:        c.tags,
+			Owner:       models.UserCard{VKID: userIDs[c.owner]},
+		}
+		err = s.clubsUcase.CreateClub(club)
+		if err != nil {
+			return fmt.Errorf("club %s: %w", c.name, err)
+		}
+		clubIDs[i] = club.ID
+
+		photo, err := demoPhoto("club", c.color)
+		if err != nil {
+			return err
+		}
+		_, err = s.clubsUcase.UpdateAvatar(int64(club.ID), photo)
+		if err != nil {
+			return fmt.Errorf("club photo of %s: %w", c.name, err)
+		}
+
+		// Everybody else joins: the next user as a participant, the rest as subscribers.
+		for j := range userIDs {
+			if j == c.owner {
+				continue
+			}
+			status := "subscriber"
+			if j == (c.owner+1)%len(userIDs) {
+				status = "participant"
+			}
+			err = s.clubsUcase.SetUserStatusByClubID(int64(club.ID), int64(userIDs[j]), status)
+			if err != nil {
+				return fmt.Errorf("club %s member: %w", c.name, err)
+			}
+		}
+	}
+
+	for _, e := range demoEvents {
+		event := &models.Event{
+			Name:        e.name,
+			Club:        models.Club{ID: clubIDs[e.club]},
+			Creator:     models.UserCard{VKID: userIDs[e.creator]},
+			Description: e.description,
+			EventDate:   time.Now().Add(e.in).Truncate(time.Hour),
+			Latitude:    e.latitude,
+			Longitude:   e.longitude,
+		}
+		err = s.eventsUcase.CreateEvent(event)
+		if err != nil {
+			return fmt.Errorf("event %s: %w", e.name, err)
+		}
+
+		photo, err := demoPhoto("event", e.color)
+		if err != nil {
+			return err
+		}
+		_, err = s.eventsUcase.UpdateAvatar(int64(event.ID), photo)
+		if err != nil {
+			return fmt.Errorf("event photo of %s: %w", e.name, err)
+		}
+
+		err = s.eventsUcase.SetUserStatusByEventID(int64(event.ID), int64(userIDs[(e.creator+1)%len(userIDs)]), "spectator")
+		if err != nil {
+			return fmt.Errorf("event %s spectator: %w", e.name, err)
+		}
+	}
+
+	now := time.Now()
+	miniEvents := []*models.MiniEvent{
+		{Type: models.MiniEventType{ID: 1}, User: models.UserCard{VKID: userIDs[2]}, Description: "Сел на брюхо на съезде с МКАД, нужен трос",
+			CreatedAt: now, EndedAt: now.Add(2 * time.Hour), Latitude: 55.631, Longitude: 37.454},
+		{Type: models.MiniEventType{ID: 2}, User: models.UserCard{VKID: userIDs[3]}, Description: "Стоим на парковке у Лужников, подъезжайте",
+			CreatedAt: now, EndedAt: now.Add(3 * time.Hour), Latitude: 55.715, Longitude: 37.553},
+	}
+	for _, miniEvent := range miniEvents {
+		err = s.miniEventsUcase.CreateMiniEvent(miniEvent)
+		if err != nil {
+			return fmt.Errorf("mini event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// demoPhoto draws a diagonal gradient of c and wraps it into an uploaded PNG file,
+// which the usecases then convert like any other upload.
+func demoPhoto(name string, c color.RGBA) (*multipart.FileHeader, error) {
+	const width, height = 640, 400
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			shade := 0.55 + 0.45*float64(x+y)/float64(width+height)
+			img.Set(x, y, color.RGBA{
+				R: uint8(float64(c.R) * shade),
+				G: uint8(float64(c.G) * shade),
+				B: uint8(float64(c.B) * shade),
+				A: 0xff,
+			})
+		}
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fw, err := writer.CreateFormFile("file", name+".png")
+	if err != nil {
+		return nil, err
+	}
+	err = png.Encode(fw, img)
+	if err != nil {
+		return nil, err
+	}
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(int64(body.Len()) + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return form.File["file"][0], nil
+}
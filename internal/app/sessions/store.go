@@ -0,0 +1,35 @@
+package sessions
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"time"
+)
+
+var (
+	ErrSessionNotFound = apperrors.NotFound("session not found")
+	ErrSessionExpired  = apperrors.Unauthorized("session expired")
+
+	ErrRefreshTokenNotFound = apperrors.Unauthorized("refresh token not found")
+	ErrRefreshTokenExpired  = apperrors.Unauthorized("refresh token expired")
+	ErrRefreshTokenReused   = apperrors.Unauthorized("refresh token reused")
+)
+
+type SessionStore interface {
+	Insert(session *models.Session) error
+	SelectByValue(sessValue string) (*models.Session, error)
+	DeleteByValue(sessionValue string) error
+	SelectByUserID(userID uint64) ([]*models.Session, error)
+	Update(session *models.Session) error
+	DeleteByUserID(userID uint64) error
+
+	InsertRefreshToken(token *models.RefreshToken) error
+	// UseRefreshToken atomically marks the token as used and returns its previous state,
+	// so a returned token with Used set means it is being replayed.
+	UseRefreshToken(value string) (*models.RefreshToken, error)
+	// DeleteFamily removes every refresh token of the family and the sessions issued with them.
+	DeleteFamily(familyID string) error
+	// DeleteExpired removes the sessions and refresh tokens that expired before now
+	// and returns how many it removed.
+	DeleteExpired(now time.Time) (int64, error)
+}
@@ -0,0 +1,192 @@
+package sessions_memory
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/sessions"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps sessions in the process memory. Sessions are lost on restart,
+// so it is meant for local development and tests.
+type MemoryStore struct {
+	mtx           sync.RWMutex
+	sessions      map[string]*models.Session
+	refreshTokens map[string]*models.RefreshToken
+	done          chan struct{}
+}
+
+// NewMemoryStore starts a janitor that evicts expired sessions and refresh tokens every evictInterval.
+func NewMemoryStore(evictInterval time.Duration) *MemoryStore {
+	ms := &MemoryStore{
+		sessions:      make(map[string]*models.Session),
+		refreshTokens: make(map[string]*models.RefreshToken),
+		done:          make(chan struct{}),
+	}
+
+	go ms.evictLoop(evictInterval)
+
+	return ms
+}
+
+func (ms *MemoryStore) Insert(session *models.Session) error {
+	sess := *session
+
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+	ms.sessions[session.Value] = &sess
+
+	return nil
+}
+
+func (ms *MemoryStore) SelectByValue(sessValue string) (*models.Session, error) {
+	ms.mtx.RLock()
+	defer ms.mtx.RUnlock()
+
+	sess, ok := ms.sessions[sessValue]
+	if !ok || sess.ExpiresAt.Before(time.Now()) {
+		return nil, sessions.ErrSessionNotFound
+	}
+
+	result := *sess
+	return &result, nil
+}
+
+func (ms *MemoryStore) DeleteByValue(sessionValue string) error {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+	delete(ms.sessions, sessionValue)
+
+	return nil
+}
+
+func (ms *MemoryStore) SelectByUserID(userID uint64) ([]*models.Session, error) {
+	ms.mtx.RLock()
+	defer ms.mtx.RUnlock()
+
+	now := time.Now()
+	var userSessions []*models.Session
+	for _, sess := range ms.sessions {
+		if sess.UserID != userID || sess.ExpiresAt.Before(now) {
+			continue
+		}
+		result := *sess
+		userSessions = append(userSessions, &result)
+	}
+
+	return userSessions, nil
+}
+
+func (ms *MemoryStore) Update(session *models.Session) error {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+
+	if _, ok := ms.sessions[session.Value]; !ok {
+		return sessions.ErrSessionNotFound
+	}
+	sess := *session
+	ms.sessions[session.Value] = &sess
+
+	return nil
+}
+
+func (ms *MemoryStore) DeleteByUserID(userID uint64) error {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+
+	for value, sess := range ms.sessions {
+		if sess.UserID == userID {
+			delete(ms.sessions, value)
+		}
+	}
+	for value, token := range ms.refreshTokens {
+		if token.UserID == userID {
+			delete(ms.refreshTokens, value)
+		}
+	}
+
+	return nil
+}
+
+func (ms *MemoryStore) InsertRefreshToken(token *models.RefreshToken) error {
+	stored := *token
+
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+	ms.refreshTokens[token.Value] = &stored
+
+	return nil
+}
+
+func (ms *MemoryStore) UseRefreshToken(value string) (*models.RefreshToken, error) {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+
+	token, ok := ms.refreshTokens[value]
+	if !ok {
+		return nil, sessions.ErrRefreshTokenNotFound
+	}
+
+	previous := *token
+	token.Used = true
+
+	return &previous, nil
+}
+
+func (ms *MemoryStore) DeleteFamily(familyID string) error {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+
+	for value, token := range ms.refreshTokens {
+		if token.FamilyID == familyID {
+			delete(ms.sessions, token.SessionValue)
+			delete(ms.refreshTokens, value)
+		}
+	}
+
+	return nil
+}
+
+func (ms *MemoryStore) DeleteExpired(now time.Time) (int64, error) {
+	return ms.evictExpired(now), nil
+}
+
+// Stop terminates the eviction goroutine.
+func (ms *MemoryStore) Stop() {
+	close(ms.done)
+}
+
+func (ms *MemoryStore) evictLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ms.evictExpired(time.Now())
+		case <-ms.done:
+			return
+		}
+	}
+}
+
+func (ms *MemoryStore) evictExpired(now time.Time) int64 {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+
+	var evicted int64
+	for value, sess := range ms.sessions {
+		if sess.ExpiresAt.Before(now) {
+			delete(ms.sessions, value)
+			evicted++
+		}
+	}
+	for value, token := range ms.refreshTokens {
+		if token.ExpiresAt.Before(now) {
+			delete(ms.refreshTokens, value)
+			evicted++
+		}
+	}
+
+	return evicted
+}
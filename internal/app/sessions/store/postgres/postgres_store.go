@@ -0,0 +1,168 @@
+package sessions_postgres
+
+import (
+	"database/sql"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/sessions"
+	"time"
+)
+
+type PostgresStore struct {
+	dbConn *sql.DB
+}
+
+func NewPostgresStore(conn *sql.DB) sessions.SessionStore {
+	return &PostgresStore{
+		dbConn: conn,
+	}
+}
+
+func (ps *PostgresStore) Insert(session *models.Session) error {
+	_, err := ps.dbConn.Exec(
+		`INSERT INTO sessions (id, value, family_id, user_id, expires_at, created_at, last_seen_at, user_agent, ip)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		session.ID, session.Value, session.FamilyID, session.UserID, session.ExpiresAt, session.CreatedAt, session.LastSeenAt, session.UserAgent, session.IP)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ps *PostgresStore) SelectByValue(sessValue string) (*models.Session, error) {
+	sess := &models.Session{}
+	err := ps.dbConn.QueryRow(
+		`SELECT id, value, family_id, user_id, expires_at, created_at, last_seen_at, user_agent, ip FROM sessions
+				WHERE value = $1`, sessValue).Scan(
+		&sess.ID, &sess.Value, &sess.FamilyID, &sess.UserID, &sess.ExpiresAt, &sess.CreatedAt, &sess.LastSeenAt, &sess.UserAgent, &sess.IP)
+	if err == sql.ErrNoRows {
+		return nil, sessions.ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sess, nil
+}
+
+func (ps *PostgresStore) DeleteByValue(sessionValue string) error {
+	_, err := ps.dbConn.Exec(`DELETE FROM sessions WHERE value = $1`, sessionValue)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ps *PostgresStore) SelectByUserID(userID uint64) ([]*models.Session, error) {
+	var userSessions []*models.Session
+	rows, err := ps.dbConn.Query(
+		`SELECT id, value, family_id, user_id, expires_at, created_at, last_seen_at, user_agent, ip FROM sessions
+				WHERE user_id = $1 AND expires_at > now()
+				ORDER BY last_seen_at desc`, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		sess := &models.Session{}
+		err = rows.Scan(&sess.ID, &sess.Value, &sess.FamilyID, &sess.UserID, &sess.ExpiresAt, &sess.CreatedAt, &sess.LastSeenAt, &sess.UserAgent, &sess.IP)
+		if err != nil {
+			return nil, err
+		}
+		userSessions = append(userSessions, sess)
+	}
+	return userSessions, nil
+}
+
+func (ps *PostgresStore) Update(session *models.Session) error {
+	_, err := ps.dbConn.Exec(
+		`UPDATE sessions SET expires_at = $2, last_seen_at = $3, user_agent = $4, ip = $5 WHERE value = $1`,
+		session.Value, session.ExpiresAt, session.LastSeenAt, session.UserAgent, session.IP)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ps *PostgresStore) DeleteByUserID(userID uint64) error {
+	_, err := ps.dbConn.Exec(`DELETE FROM sessions WHERE user_id = $1`, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = ps.dbConn.Exec(`DELETE FROM refresh_tokens WHERE user_id = $1`, userID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ps *PostgresStore) InsertRefreshToken(token *models.RefreshToken) error {
+	_, err := ps.dbConn.Exec(
+		`INSERT INTO refresh_tokens (value, family_id, user_id, session_value, expires_at, created_at, used)
+				VALUES ($1, $2, $3, $4, $5, $6, false)`,
+		token.Value, token.FamilyID, token.UserID, token.SessionValue, token.ExpiresAt, token.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ps *PostgresStore) UseRefreshToken(value string) (*models.RefreshToken, error) {
+	token := &models.RefreshToken{}
+	err := ps.dbConn.QueryRow(
+		`UPDATE refresh_tokens AS rt SET used = true
+				FROM (SELECT value, used FROM refresh_tokens WHERE value = $1 FOR UPDATE) AS old
+				WHERE rt.value = old.value
+				RETURNING rt.value, rt.family_id, rt.user_id, rt.session_value, rt.expires_at, rt.created_at, old.used`, value).Scan(
+		&token.Value, &token.FamilyID, &token.UserID, &token.SessionValue, &token.ExpiresAt, &token.CreatedAt, &token.Used)
+	if err == sql.ErrNoRows {
+		return nil, sessions.ErrRefreshTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (ps *PostgresStore) DeleteFamily(familyID string) error {
+	_, err := ps.dbConn.Exec(`DELETE FROM sessions WHERE family_id = $1`, familyID)
+	if err != nil {
+		return err
+	}
+
+	_, err = ps.dbConn.Exec(`DELETE FROM refresh_tokens WHERE family_id = $1`, familyID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ps *PostgresStore) DeleteExpired(now time.Time) (int64, error) {
+	var deleted int64
+	for _, query := range []string{
+		`DELETE FROM sessions WHERE expires_at < $1`,
+		`DELETE FROM refresh_tokens WHERE expires_at < $1`,
+	} {
+		res, err := ps.dbConn.Exec(query, now)
+		if err != nil {
+			return deleted, err
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return deleted, err
+		}
+		deleted += affected
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,269 @@
+package sessions_tarantool
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/dantedoyl/car-life-api/internal/app/metrics"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/sessions"
+	"github.com/tarantool/go-tarantool"
+	"time"
+)
+
+type TarantoolStore struct {
+	conn *tarantool.Connection
+}
+
+func NewTarantoolStore(conn *tarantool.Connection) sessions.SessionStore {
+	return &TarantoolStore{
+		conn: conn,
+	}
+}
+
+func (ts *TarantoolStore) Insert(session *models.Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	_, err = ts.conn.Insert("sessions", []interface{}{session.Value, string(data), session.UserID})
+	observe("insert_session", start)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ts *TarantoolStore) SelectByValue(sessValue string) (*models.Session, error) {
+	start := time.Now()
+	resp, err := ts.conn.Call("check_session", []interface{}{sessValue})
+	observe("check_session", start)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Data) == 0 || resp.Data[0] == nil {
+		return nil, sessions.ErrSessionNotFound
+	}
+
+	return parseSessionTuple(resp.Data[0])
+}
+
+func (ts *TarantoolStore) DeleteByValue(sessionValue string) error {
+	start := time.Now()
+	_, err := ts.conn.Delete("sessions", "primary", []interface{}{sessionValue})
+	observe("delete_session", start)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ts *TarantoolStore) SelectByUserID(userID uint64) ([]*models.Session, error) {
+	start := time.Now()
+	resp, err := ts.conn.Select("sessions", "user", 0, 1000, tarantool.IterEq, []interface{}{userID})
+	observe("select_user_sessions", start)
+	if err != nil {
+		return nil, err
+	}
+
+	var userSessions []*models.Session
+	for _, tuple := range resp.Data {
+		sess, err := parseSessionTuple(tuple)
+		if err != nil {
+			return nil, err
+		}
+		userSessions = append(userSessions, sess)
+	}
+
+	return userSessions, nil
+}
+
+func (ts *TarantoolStore) Update(session *models.Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	_, err = ts.conn.Replace("sessions", []interface{}{session.Value, string(data), session.UserID})
+	observe("update_session", start)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ts *TarantoolStore) DeleteByUserID(userID uint64) error {
+	start := time.Now()
+	_, err := ts.conn.Call("delete_user_sessions", []interface{}{userID})
+	observe("delete_user_sessions", start)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ts *TarantoolStore) InsertRefreshToken(token *models.RefreshToken) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	_, err = ts.conn.Insert("refresh_tokens", []interface{}{token.Value, string(data), token.FamilyID, false, token.UserID})
+	observe("insert_refresh_token", start)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ts *TarantoolStore) UseRefreshToken(value string) (*models.RefreshToken, error) {
+	start := time.Now()
+	resp, err := ts.conn.Call("use_refresh_token", []interface{}{value})
+	observe("use_refresh_token", start)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Data) == 0 || resp.Data[0] == nil {
+		return nil, sessions.ErrRefreshTokenNotFound
+	}
+
+	tokenDataSlice, ok := resp.Data[0].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("cannot cast data")
+	}
+
+	if len(tokenDataSlice) < 4 || tokenDataSlice[0] == nil {
+		return nil, sessions.ErrRefreshTokenNotFound
+	}
+
+	tokenData, ok := tokenDataSlice[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("cannot cast to string")
+	}
+
+	token := &models.RefreshToken{}
+	err = json.Unmarshal([]byte(tokenData), token)
+	if err != nil {
+		return nil, err
+	}
+
+	token.Used, _ = tokenDataSlice[3].(bool)
+	return token, nil
+}
+
+func (ts *TarantoolStore) DeleteFamily(familyID string) error {
+	start := time.Now()
+	_, err := ts.conn.Call("delete_refresh_family", []interface{}{familyID})
+	observe("delete_refresh_family", start)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteExpired scans both spaces, because the expiry is only known from the JSON payload.
+func (ts *TarantoolStore) DeleteExpired(now time.Time) (int64, error) {
+	var deleted int64
+	for _, space := range []string{"sessions", "refresh_tokens"} {
+		values, err := ts.expiredValues(space, now)
+		if err != nil {
+			return deleted, err
+		}
+
+		for _, value := range values {
+			start := time.Now()
+			_, err = ts.conn.Delete(space, "primary", []interface{}{value})
+			observe("delete_expired", start)
+			if err != nil {
+				return deleted, err
+			}
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+func (ts *TarantoolStore) expiredValues(space string, now time.Time) ([]string, error) {
+	const batch = 1000
+
+	var values []string
+	for offset := uint32(0); ; offset += batch {
+		start := time.Now()
+		resp, err := ts.conn.Select(space, "primary", offset, batch, tarantool.IterAll, []interface{}{})
+		observe("select_expired", start)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tuple := range resp.Data {
+			fields, ok := tuple.([]interface{})
+			if !ok || len(fields) < 2 {
+				return nil, fmt.Errorf("cannot cast data")
+			}
+
+			value, ok := fields[0].(string)
+			if !ok {
+				return nil, fmt.Errorf("cannot cast to string")
+			}
+			data, ok := fields[1].(string)
+			if !ok {
+				return nil, fmt.Errorf("cannot cast to string")
+			}
+
+			expiry := struct {
+				ExpiresAt time.Time `json:"expires_at"`
+			}{}
+			err = json.Unmarshal([]byte(data), &expiry)
+			if err != nil {
+				return nil, err
+			}
+			if expiry.ExpiresAt.Before(now) {
+				values = append(values, value)
+			}
+		}
+
+		if len(resp.Data) < batch {
+			return values, nil
+		}
+	}
+}
+
+func observe(operation string, start time.Time) {
+	metrics.ObserveSince(metrics.TarantoolCallDuration.WithLabelValues(operation), start)
+}
+
+func parseSessionTuple(data interface{}) (*models.Session, error) {
+	sessionDataSlice, ok := data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("cannot cast data")
+	}
+
+	if len(sessionDataSlice) < 2 || sessionDataSlice[0] == nil {
+		return nil, sessions.ErrSessionNotFound
+	}
+
+	sessionData, ok := sessionDataSlice[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("cannot cast to string")
+	}
+
+	sess := &models.Session{}
+	err := json.Unmarshal([]byte(sessionData), sess)
+	if err != nil {
+		return nil, err
+	}
+
+	return sess, nil
+}
@@ -0,0 +1,161 @@
+package delivery
+
+import (
+	"context"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/grpcapi"
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	users "github.com/dantedoyl/car-life-api/internal/app/users"
+	"github.com/dantedoyl/car-life-api/pkg/carlifepb"
+)
+
+type UsersServer struct {
+	carlifepb.UnimplementedUsersServiceServer
+	usersUcase users.IUsersUsecase
+}
+
+func NewUsersServer(usersUcase users.IUsersUsecase) *UsersServer {
+	return &UsersServer{
+		usersUcase: usersUcase,
+	}
+}
+
+func (us *UsersServer) GetMe(ctx context.Context, req *carlifepb.GetMeRequest) (*carlifepb.User, error) {
+	userID, ok := ctx.Value("userID").(uint64)
+	if !ok {
+		return nil, apperrors.Unauthorized("you're unauthorized")
+	}
+
+	user, err := us.usersUcase.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return toUser(user), nil
+}
+
+func (us *UsersServer) GetUser(ctx context.Context, req *carlifepb.GetUserRequest) (*carlifepb.User, error) {
+	user, err := us.usersUcase.GetByID(req.VkId)
+	if err != nil {
+		return nil, err
+	}
+	return toUser(user), nil
+}
+
+func (us *UsersServer) GetUsers(ctx context.Context, req *carlifepb.GetUsersRequest) (*carlifepb.GetUsersResponse, error) {
+	err := grpcapi.CheckIDs("vk_ids", req.VkIds)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := us.usersUcase.GetByIDs(req.VkIds)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.GetUsersResponse{Users: make([]*carlifepb.User, 0, len(found))}
+	for _, user := range found {
+		resp.Users = append(resp.Users, toUser(user))
+	}
+	return resp, nil
+}
+
+func (us *UsersServer) UpdateMe(ctx context.Context, req *carlifepb.UpdateMeRequest) (*carlifepb.User, error) {
+	userID, ok := ctx.Value("userID").(uint64)
+	if !ok {
+		return nil, apperrors.Unauthorized("you're unauthorized")
+	}
+
+	update := models.UpdateRequest{Tags: req.Tags, Description: req.Description}
+	err := update.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := us.usersUcase.UpdateUserInfo(&models.User{
+		VKID:        userID,
+		Tags:        update.Tags,
+		Description: update.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toUser(user), nil
+}
+
+func (us *UsersServer) GetCar(ctx context.Context, req *carlifepb.GetCarRequest) (*carlifepb.Car, error) {
+	userID, _ := ctx.Value("userID").(uint64)
+
+	car, err := us.usersUcase.SelectCarByID(int64(req.Id), userID)
+	if err != nil {
+		return nil, err
+	}
+	return grpcapi.Car(car), nil
+}
+
+func (us *UsersServer) ListUserCars(ctx context.Context, req *carlifepb.ListUserCarsRequest) (*carlifepb.CarList, error) {
+	page, err := grpcapi.Page(req.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	cars, next, err := us.usersUcase.SelectCarByUserID(int64(req.VkId), page)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.CarList{Items: grpcapi.Cars(cars)}
+	resp.NextCursor, resp.HasMore = grpcapi.NextCursor(next)
+	return resp, nil
+}
+
+func (us *UsersServer) ListUserClubs(ctx context.Context, req *carlifepb.ListUserClubsRequest) (*carlifepb.ClubCardList, error) {
+	err := grpcapi.CheckStatus(req.Status, "admin", "participant", "subscriber")
+	if err != nil {
+		return nil, err
+	}
+	page, err := grpcapi.Page(req.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	cards, next, err := us.usersUcase.GetClubsByUserStatus(int64(req.VkId), req.Status, page)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.ClubCardList{Items: grpcapi.ClubCards(cards)}
+	resp.NextCursor, resp.HasMore = grpcapi.NextCursor(next)
+	return resp, nil
+}
+
+func (us *UsersServer) ListUserEvents(ctx context.Context, req *carlifepb.ListUserEventsRequest) (*carlifepb.EventCardList, error) {
+	err := grpcapi.CheckStatus(req.Status, "admin", "participant", "spectator")
+	if err != nil {
+		return nil, err
+	}
+	page, err := grpcapi.Page(req.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	cards, next, err := us.usersUcase.GetEventsByUserStatus(int64(req.VkId), req.Status, page)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carlifepb.EventCardList{Items: grpcapi.EventCards(cards)}
+	resp.NextCursor, resp.HasMore = grpcapi.NextCursor(next)
+	return resp, nil
+}
+
+func toUser(user *models.User) *carlifepb.User {
+	return &carlifepb.User{
+		VkId:        user.VKID,
+		Name:        user.Name,
+		Surname:     user.Surname,
+		AvatarUrl:   user.AvatarUrl,
+		Tags:        user.Tags,
+		Description: user.Description,
+		UpdatedAt:   grpcapi.Timestamp(user.UpdatedAt),
+	}
+}
@@ -2,8 +2,14 @@ package delivery
 
 import (
 	"encoding/json"
+	"errors"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/authorization"
+	"github.com/dantedoyl/car-life-api/internal/app/clients/vk"
 	"github.com/dantedoyl/car-life-api/internal/app/middleware"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/ratelimit"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"github.com/dantedoyl/car-life-api/internal/app/users"
 	"github.com/dantedoyl/car-life-api/internal/app/utils"
 	"github.com/gorilla/mux"
@@ -13,31 +19,63 @@ import (
 )
 
 type UsersHandler struct {
-	usersUcase users.IUsersUsecase
+	usersUcase   users.IUsersUsecase
+	launchParams *vk.LaunchParamsVerifier
 }
 
-func NewUserssHandler(usersUcase users.IUsersUsecase) *UsersHandler {
+func NewUserssHandler(usersUcase users.IUsersUsecase, launchParams *vk.LaunchParamsVerifier) *UsersHandler {
 	return &UsersHandler{
-		usersUcase: usersUcase,
+		usersUcase:   usersUcase,
+		launchParams: launchParams,
 	}
 }
 
 func (uh *UsersHandler) Configure(r *mux.Router, mw *middleware.Middleware) {
-	r.HandleFunc("/signup", uh.SignUp).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/me", mw.CheckAuthMiddleware(uh.MyProfile)).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/user/{id:[0-9]+}", uh.UserProfile).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/signup", mw.RateLimit(ratelimit.ClassAuth, uh.SignUp)).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/me", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(uh.MyProfile))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/user/{id:[0-9]+}", middleware.ETag(middleware.CacheRevalidate, uh.UserProfile)).Methods(http.MethodGet, http.MethodOptions)
 	r.HandleFunc("/me/update", mw.CheckAuthMiddleware(uh.UpdateUserProfile)).Methods(http.MethodPut, http.MethodOptions)
-	r.HandleFunc("/new_car", mw.CheckAuthMiddleware(uh.NewUserCar)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/user/{id:[0-9]+}/garage", uh.UserGarage).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/user/{id:[0-9]+}/complain", mw.CheckAuthMiddleware(uh.ComplainUser)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/user/{id:[0-9]+}/events/{type:admin|participant|spectator}", uh.UserEvents).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/user/{id:[0-9]+}/clubs/{type:admin|participant|subscriber}", uh.UserClubs).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/user/own_clubs", mw.CheckAuthMiddleware(uh.UserOwnClubs)).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/login", uh.Login).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/garage/{id:[0-9]+}/upload", uh.UploadAvatarHandler).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/garage/{id:[0-9]+}", uh.GetCarByID).Methods(http.MethodGet, http.MethodOptions)
-	r.HandleFunc("/garage/{id:[0-9]+}/delete", mw.CheckAuthMiddleware(uh.DeleteCar)).Methods(http.MethodPost, http.MethodOptions)
-	r.HandleFunc("/garage/{id:[0-9]+}/complain", mw.CheckAuthMiddleware(uh.ComplainCar)).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/new_car", mw.CheckAuthMiddleware(mw.Idempotent(uh.NewUserCar))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/user/{id:[0-9]+}/garage", middleware.ETag(middleware.CacheRevalidate, uh.UserGarage)).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/user/{id:[0-9]+}/complain", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassComplaints, uh.ComplainUser))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/user/{id:[0-9]+}/events/{type:admin|participant|spectator}", middleware.ETag(middleware.CacheRevalidate, uh.UserEvents)).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/user/{id:[0-9]+}/clubs/{type:admin|participant|subscriber}", middleware.ETag(middleware.CacheRevalidate, uh.UserClubs)).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/user/own_clubs", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(uh.UserOwnClubs))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/login", mw.RateLimit(ratelimit.ClassAuth, uh.Login)).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/session/refresh", mw.RateLimit(ratelimit.ClassAuth, uh.RefreshSession)).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/logout", mw.CheckAuthMiddleware(uh.Logout)).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/me/sessions", mw.CheckAuthMiddleware(uh.UserSessions)).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/me/sessions", mw.CheckAuthMiddleware(uh.DeleteAllUserSessions)).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/me/sessions/{id:[0-9a-f-]+}", mw.CheckAuthMiddleware(uh.DeleteUserSession)).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/garage/{id:[0-9]+}/upload", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassUploads, mw.RequirePermission(authorization.ActionCarUpdate, "id", uh.UploadAvatarHandler)))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/garage/{id:[0-9]+}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(uh.GetCarByID))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/garage/{id:[0-9]+}/delete", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionCarDelete, "id", uh.DeleteCar))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/garage/{id:[0-9]+}/complain", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassComplaints, uh.ComplainCar))).Methods(http.MethodPost, http.MethodOptions)
+}
+
+// ConfigureV2 registers the v2 routes. Signing up creates a user, logging in creates a session,
+// and the garage is the /cars collection.
+func (uh *UsersHandler) ConfigureV2(r *mux.Router, mw *middleware.Middleware) {
+	r.HandleFunc("/users", mw.RateLimit(ratelimit.ClassAuth, uh.SignUpV2)).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/users/{id:[0-9]+}", middleware.ETag(middleware.CacheRevalidate, uh.UserProfile)).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/users/{id:[0-9]+}/cars", middleware.ETag(middleware.CacheRevalidate, uh.UserGarage)).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/users/{id:[0-9]+}/events/{type:admin|participant|spectator}", middleware.ETag(middleware.CacheRevalidate, uh.UserEvents)).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/users/{id:[0-9]+}/clubs/{type:admin|participant|subscriber}", middleware.ETag(middleware.CacheRevalidate, uh.UserClubs)).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/users/{id:[0-9]+}/complaints", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassComplaints, uh.ComplainUser))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/me", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(uh.MyProfile))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/me", mw.CheckAuthMiddleware(uh.UpdateUserProfile)).Methods(http.MethodPut, http.MethodOptions)
+	r.HandleFunc("/me/own_clubs", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(uh.UserOwnClubs))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/sessions", mw.RateLimit(ratelimit.ClassAuth, uh.Login)).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/sessions/refresh", mw.RateLimit(ratelimit.ClassAuth, uh.RefreshSession)).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/me/sessions", mw.CheckAuthMiddleware(uh.UserSessions)).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/me/sessions", mw.CheckAuthMiddleware(uh.DeleteAllUserSessions)).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/me/sessions/current", mw.CheckAuthMiddleware(uh.Logout)).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/me/sessions/{id:[0-9a-f-]+}", mw.CheckAuthMiddleware(uh.DeleteUserSession)).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/cars", mw.CheckAuthMiddleware(mw.Idempotent(uh.NewUserCarV2))).Methods(http.MethodPost, http.MethodOptions)
+	r.HandleFunc("/cars/{id:[0-9]+}", middleware.ETag(middleware.CacheRevalidate, mw.CheckAuthMiddleware(uh.GetCarByID))).Methods(http.MethodGet, http.MethodOptions)
+	r.HandleFunc("/cars/{id:[0-9]+}", mw.CheckAuthMiddleware(mw.RequirePermission(authorization.ActionCarDelete, "id", uh.DeleteCar))).Methods(http.MethodDelete, http.MethodOptions)
+	r.HandleFunc("/cars/{id:[0-9]+}/avatar", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassUploads, mw.RequirePermission(authorization.ActionCarUpdate, "id", uh.UploadAvatarHandler)))).Methods(http.MethodPut, http.MethodOptions)
+	r.HandleFunc("/cars/{id:[0-9]+}/complaints", mw.CheckAuthMiddleware(mw.RateLimit(ratelimit.ClassComplaints, uh.ComplainCar))).Methods(http.MethodPost, http.MethodOptions)
 }
 
 // SignUp godoc
@@ -49,22 +87,62 @@ func (uh *UsersHandler) Configure(r *mux.Router, mw *middleware.Middleware) {
 // @Param        body body models.SignUpRequest true "User"
 // @Success      200 {object} models.SignUpResponse
 // @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /signup [post]
 func (uh *UsersHandler) SignUp(w http.ResponseWriter, r *http.Request) {
+	_, resp, ok := uh.signUp(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := json.Marshal(resp)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// SignUpV2 is SignUp of API v2: it answers 201 with the new user in the Location header.
+func (uh *UsersHandler) SignUpV2(w http.ResponseWriter, r *http.Request) {
+	user, resp, ok := uh.signUp(w, r)
+	if !ok {
+		return
+	}
+
+	utils.WriteCreated(w, r, r.URL.Path+"/"+strconv.FormatUint(user.VKID, 10), resp)
+}
+
+// signUp creates the user and starts their session, whose cookie it sets.
+// On failure it writes the error and returns false.
+func (uh *UsersHandler) signUp(w http.ResponseWriter, r *http.Request) (*models.User, *models.SignUpResponse, bool) {
 	defer r.Body.Close()
 
 	signUp := models.SignUpRequest{}
 	err := json.NewDecoder(r.Body).Decode(&signUp)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "unable to decode data"}))
-		return
+		utils.WriteError(w, r, apperrors.Validation("unable to decode data"))
+		return nil, nil, false
+	}
+
+	err = signUp.Validate()
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return nil, nil, false
+	}
+
+	launchParams, err := uh.launchParams.Verify(signUp.LaunchParams)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return nil, nil, false
 	}
 
 	user := &models.User{
-		VKID:        signUp.VKID,
+		VKID:        launchParams.UserID,
 		Tags:        signUp.Tags,
 		Name:        signUp.Name,
 		Surname:     signUp.Surname,
@@ -88,41 +166,25 @@ func (uh *UsersHandler) SignUp(w http.ResponseWriter, r *http.Request) {
 
 	user, err = uh.usersUcase.Create(user, car)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
+		utils.WriteError(w, r, err)
+		return nil, nil, false
 	}
 
-	session := models.CreateSession(user.VKID)
-	err = uh.usersUcase.CreateSession(session)
+	session, refreshToken, err := uh.usersUcase.StartSession(user.VKID, r.UserAgent(), utils.ClientIP(r))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
-	}
-
-	cookie := http.Cookie{
-		Name:     "session_id",
-		Value:    session.Value,
-		Expires:  session.ExpiresAt,
-		Secure:   true,
-		SameSite: http.SameSiteNoneMode,
-		HttpOnly: true,
-	}
-
-	body, err := json.Marshal(models.SignUpResponse{
-		CarID:   user.CarID,
-		Session: session,
-	})
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
-		return
+		utils.WriteError(w, r, err)
+		return nil, nil, false
 	}
 
+	cookie := sessionCookie(session, refreshToken)
 	http.SetCookie(w, &cookie)
-	w.WriteHeader(http.StatusOK)
-	w.Write(body)
+
+	return user, &models.SignUpResponse{
+		CarID:                 user.CarID,
+		Session:               session,
+		RefreshToken:          refreshToken.Value,
+		RefreshTokenExpiresAt: refreshToken.ExpiresAt,
+	}, true
 }
 
 // Login godoc
@@ -132,9 +194,10 @@ func (uh *UsersHandler) SignUp(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Param        body body models.LoginRequest true "User"
-// @Success      200  {object}  models.Session
+// @Success      200  {object}  models.AuthResponse
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /login [post]
@@ -142,44 +205,91 @@ func (uh *UsersHandler) Login(w http.ResponseWriter, r *http.Request) {
 	login := &models.LoginRequest{}
 	err := json.NewDecoder(r.Body).Decode(&login)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "unable to decode data"}))
+		utils.WriteError(w, r, apperrors.Validation("unable to decode data"))
+		return
+	}
+
+	launchParams, err := uh.launchParams.Verify(login.LaunchParams)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	user, err := uh.usersUcase.GetByID(login.VKID)
+	user, err := uh.usersUcase.GetByID(launchParams.UserID)
+	if errors.Is(err, users.ErrUserNotFound) {
+		utils.WriteError(w, r, apperrors.Unauthorized("user is not signed up"))
+		return
+	}
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	if user == nil {
-		w.WriteHeader(http.StatusUnauthorized)
+	if user.Banned {
+		utils.WriteError(w, r, apperrors.Forbidden("user is banned"))
 		return
 	}
 
-	session := models.CreateSession(login.VKID)
-	err = uh.usersUcase.CreateSession(session)
+	session, refreshToken, err := uh.usersUcase.StartSession(user.VKID, r.UserAgent(), utils.ClientIP(r))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	cookie := http.Cookie{
-		Name:     "session_id",
-		Value:    session.Value,
-		Expires:  session.ExpiresAt,
-		Secure:   true,
-		SameSite: http.SameSiteNoneMode,
-		HttpOnly: true,
+	cookie := sessionCookie(session, refreshToken)
+
+	body, err := json.Marshal(models.AuthResponse{
+		Session:               session,
+		RefreshToken:          refreshToken.Value,
+		RefreshTokenExpiresAt: refreshToken.ExpiresAt,
+	})
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
 	}
 
-	body, err := json.Marshal(session)
+	http.SetCookie(w, &cookie)
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// RefreshSession godoc
+// @Summary      refresh session
+// @Description  Handler for exchanging a refresh token for a new session and refresh token. Every refresh token can be used once, reusing it revokes all sessions issued from the same login
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        body body models.RefreshRequest true "Refresh token"
+// @Success      200  {object}  models.AuthResponse
+// @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /session/refresh [post]
+func (uh *UsersHandler) RefreshSession(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	refresh := &models.RefreshRequest{}
+	err := json.NewDecoder(r.Body).Decode(refresh)
+	if err != nil || refresh.RefreshToken == "" {
+		utils.WriteError(w, r, apperrors.Validation("unable to decode data"))
+		return
+	}
+
+	session, refreshToken, err := uh.usersUcase.RefreshSession(refresh.RefreshToken, r.UserAgent(), utils.ClientIP(r))
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	cookie := sessionCookie(session, refreshToken)
+
+	body, err := json.Marshal(models.AuthResponse{
+		Session:               session,
+		RefreshToken:          refreshToken.Value,
+		RefreshTokenExpiresAt: refreshToken.ExpiresAt,
+	})
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -188,6 +298,19 @@ func (uh *UsersHandler) Login(w http.ResponseWriter, r *http.Request) {
 	w.Write(body)
 }
 
+// sessionCookie outlives the session itself: the session slides while the user is
+// active, so the cookie is kept for as long as the pair can be refreshed.
+func sessionCookie(session *models.Session, refreshToken *models.RefreshToken) http.Cookie {
+	return http.Cookie{
+		Name:     "session_id",
+		Value:    session.Value,
+		Expires:  refreshToken.ExpiresAt,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+		HttpOnly: true,
+	}
+}
+
 // UploadAvatarHandler godoc
 // @Summary      upload avatar for car
 // @Description  Handler for creating an event
@@ -198,6 +321,8 @@ func (uh *UsersHandler) Login(w http.ResponseWriter, r *http.Request) {
 // @Param 		 file-upload formData file true "Image to upload"
 // @Success      200  {object}  models.User
 // @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /garage/{id}/upload [post]
@@ -210,29 +335,25 @@ func (uh *UsersHandler) UploadAvatarHandler(w http.ResponseWriter, r *http.Reque
 	r.Body = http.MaxBytesReader(w, r.Body, 3*1024*1024)
 	err := r.ParseMultipartForm(3 * 1024 * 1024)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't parse data"}))
+		utils.WriteError(w, r, apperrors.Validation("can't parse data"))
 		return
 	}
 
 	if len(r.MultipartForm.File["file-upload"]) == 0 {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "no photo"}))
+		utils.WriteError(w, r, apperrors.Validation("no photo"))
 		return
 	}
 
 	file := r.MultipartForm.File["file-upload"][0]
 	user, err := uh.usersUcase.UpdateAvatar(uint64(carID), file)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	body, err := json.Marshal(user)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -259,8 +380,7 @@ func (uh *UsersHandler) UserProfile(w http.ResponseWriter, r *http.Request) {
 
 	user, err := uh.usersUcase.GetByID(userID)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -270,8 +390,7 @@ func (uh *UsersHandler) UserProfile(w http.ResponseWriter, r *http.Request) {
 
 	body, err := json.Marshal(user)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -289,6 +408,7 @@ func (uh *UsersHandler) UserProfile(w http.ResponseWriter, r *http.Request) {
 // @Param        body body models.UpdateRequest true "User"
 // @Success      200  {object}  models.User
 // @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /me/update [put]
@@ -297,16 +417,20 @@ func (uh *UsersHandler) UpdateUserProfile(w http.ResponseWriter, r *http.Request
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	signUp := models.UpdateRequest{}
 	err := json.NewDecoder(r.Body).Decode(&signUp)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "unable to decode data"}))
+		utils.WriteError(w, r, apperrors.Validation("unable to decode data"))
+		return
+	}
+
+	err = signUp.Validate()
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -318,15 +442,13 @@ func (uh *UsersHandler) UpdateUserProfile(w http.ResponseWriter, r *http.Request
 
 	user, err = uh.usersUcase.UpdateUserInfo(user)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	body, err := json.Marshal(user)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -342,21 +464,20 @@ func (uh *UsersHandler) UpdateUserProfile(w http.ResponseWriter, r *http.Request
 // @Produce      json
 // @Success      200  {object}  models.User
 // @Failure      400  {object}  utils.Error
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /me [get]
 func (uh *UsersHandler) MyProfile(w http.ResponseWriter, r *http.Request) {
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	user, err := uh.usersUcase.GetByID(userID)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -366,8 +487,7 @@ func (uh *UsersHandler) MyProfile(w http.ResponseWriter, r *http.Request) {
 
 	body, err := json.Marshal(user)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -382,20 +502,18 @@ func (uh *UsersHandler) MyProfile(w http.ResponseWriter, r *http.Request) {
 // @Tags         Users
 // @Accept       json
 // @Produce      json
-// @Param        IdGt query integer false "IdGt"
-// @Param        IdLte query integer false "IdLte"
+// @Param        Cursor query string false "Cursor"
 // @Param        Limit query integer false "Limit"
-// @Success      200  {object}  []models.ClubCard
+// @Success      200  {object}  pagination.Response{items=[]models.ClubCard}
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /user/own_clubs [get]
 func (uh *UsersHandler) UserOwnClubs(w http.ResponseWriter, r *http.Request) {
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
@@ -404,25 +522,28 @@ func (uh *UsersHandler) UserOwnClubs(w http.ResponseWriter, r *http.Request) {
 	decoder.IgnoreUnknownKeys(true)
 	err := decoder.Decode(query, r.URL.Query())
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
+		return
+	}
+
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	clubs, err := uh.usersUcase.GetClubsByUserStatus(int64(userID), "admin", query.IdGt, query.IdLte, query.Limit)
+	clubs, next, err := uh.usersUcase.GetClubsByUserStatus(int64(userID), "admin", page)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 	if len(clubs) == 0 {
 		clubs = []*models.ClubCard{}
 	}
 
-	body, err := json.Marshal(clubs)
+	body, err := json.Marshal(pagination.NewResponse(clubs, next))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -438,12 +559,11 @@ func (uh *UsersHandler) UserOwnClubs(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Param        id path int64 true "User ID"
-// @Param        IdGt query integer false "IdGt"
-// @Param        IdLte query integer false "IdLte"
+// @Param        Cursor query string false "Cursor"
 // @Param        Limit query integer false "Limit"
-// @Success      200  {object}  []models.CarCard
+// @Success      200  {object}  pagination.Response{items=[]models.CarCard}
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /user/{id}/garage [get]
@@ -456,25 +576,28 @@ func (uh *UsersHandler) UserGarage(w http.ResponseWriter, r *http.Request) {
 	decoder.IgnoreUnknownKeys(true)
 	err := decoder.Decode(query, r.URL.Query())
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
+		return
+	}
+
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	cars, err := uh.usersUcase.SelectCarByUserID(int64(userID), query.IdGt, query.IdLte, query.Limit)
+	cars, next, err := uh.usersUcase.SelectCarByUserID(int64(userID), page)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 	if len(cars) == 0 {
 		cars = []*models.CarCard{}
 	}
 
-	body, err := json.Marshal(cars)
+	body, err := json.Marshal(pagination.NewResponse(cars, next))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -490,13 +613,12 @@ func (uh *UsersHandler) UserGarage(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Param        id path int64 true "User ID"
-// @Param        IdGt query integer false "IdGt"
-// @Param        IdLte query integer false "IdLte"
+// @Param        Cursor query string false "Cursor"
 // @Param        Limit query integer false "Limit"
 // @Param        type path string true "Type" Enums(admin, participant, subscriber)
-// @Success      200  {object}  []models.ClubCard
+// @Success      200  {object}  pagination.Response{items=[]models.ClubCard}
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /user/{id}/clubs/{type} [get]
@@ -510,25 +632,28 @@ func (uh *UsersHandler) UserClubs(w http.ResponseWriter, r *http.Request) {
 	decoder.IgnoreUnknownKeys(true)
 	err := decoder.Decode(query, r.URL.Query())
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
+		return
+	}
+
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	clubs, err := uh.usersUcase.GetClubsByUserStatus(int64(userID), role, query.IdGt, query.IdLte, query.Limit)
+	clubs, next, err := uh.usersUcase.GetClubsByUserStatus(int64(userID), role, page)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 	if len(clubs) == 0 {
 		clubs = []*models.ClubCard{}
 	}
 
-	body, err := json.Marshal(clubs)
+	body, err := json.Marshal(pagination.NewResponse(clubs, next))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -544,13 +669,12 @@ func (uh *UsersHandler) UserClubs(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Param        id path int64 true "User ID"
-// @Param        IdGt query integer false "IdGt"
-// @Param        IdLte query integer false "IdLte"
+// @Param        Cursor query string false "Cursor"
 // @Param        Limit query integer false "Limit"
 // @Param        type path string true "Type" Enums(admin, participant, spectator)
-// @Success      200  {object}  []models.EventCard
+// @Success      200  {object}  pagination.Response{items=[]models.EventCard}
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /user/{id}/events/{type} [get]
@@ -564,25 +688,28 @@ func (uh *UsersHandler) UserEvents(w http.ResponseWriter, r *http.Request) {
 	decoder.IgnoreUnknownKeys(true)
 	err := decoder.Decode(query, r.URL.Query())
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, apperrors.Validation(err.Error()))
+		return
+	}
+
+	page, err := pagination.NewPage(query.Cursor, query.Limit)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
-	events, err := uh.usersUcase.GetEventsByUserStatus(int64(userID), role, query.IdGt, query.IdLte, query.Limit)
+	events, next, err := uh.usersUcase.GetEventsByUserStatus(int64(userID), role, page)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 	if len(events) == 0 {
 		events = []*models.EventCard{}
 	}
 
-	body, err := json.Marshal(events)
+	body, err := json.Marshal(pagination.NewResponse(events, next))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -598,28 +725,60 @@ func (uh *UsersHandler) UserEvents(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Produce      json
 // @Param        body body models.CarRequest true "Car"
+// @Param        Idempotency-Key header string false "Replays the first response to a retry with the same key"
 // @Success      200 {object} models.CarCard
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /new_car [post]
 func (uh *UsersHandler) NewUserCar(w http.ResponseWriter, r *http.Request) {
+	carData, ok := uh.newUserCar(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := json.Marshal(carData)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// NewUserCarV2 is NewUserCar of API v2: it answers 201 with the new car in the Location header.
+func (uh *UsersHandler) NewUserCarV2(w http.ResponseWriter, r *http.Request) {
+	carData, ok := uh.newUserCar(w, r)
+	if !ok {
+		return
+	}
+
+	utils.WriteCreated(w, r, r.URL.Path+"/"+strconv.FormatUint(carData.ID, 10), carData)
+}
+
+// newUserCar creates the car from the request body. On failure it writes the error and returns false.
+func (uh *UsersHandler) newUserCar(w http.ResponseWriter, r *http.Request) (*models.CarCard, bool) {
 	defer r.Body.Close()
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
-		return
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+		return nil, false
 	}
 
 	car := models.CarRequest{}
 	err := json.NewDecoder(r.Body).Decode(&car)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "unable to decode data"}))
-		return
+		utils.WriteError(w, r, apperrors.Validation("unable to decode data"))
+		return nil, false
+	}
+
+	err = car.Validate()
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
 	carData := &models.CarCard{
@@ -638,20 +797,11 @@ func (uh *UsersHandler) NewUserCar(w http.ResponseWriter, r *http.Request) {
 
 	carData, err = uh.usersUcase.AddNewUserCar(carData)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
+		utils.WriteError(w, r, err)
+		return nil, false
 	}
 
-	body, err := json.Marshal(carData)
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
-		return
-	}
-
-	w.WriteHeader(http.StatusOK)
-	w.Write(body)
+	return carData, true
 }
 
 // GetCarByID godoc
@@ -663,7 +813,7 @@ func (uh *UsersHandler) NewUserCar(w http.ResponseWriter, r *http.Request) {
 // @Param        id path int64 true "Car ID"
 // @Success      200  {object}  models.CarCard
 // @Failure      400  {object}  utils.Error
-// @Failure      401
+// @Failure      401  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /garage/{id} [get]
@@ -671,17 +821,17 @@ func (uh *UsersHandler) GetCarByID(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	carID, _ := strconv.ParseUint(vars["id"], 10, 64)
 
-	cars, err := uh.usersUcase.SelectCarByID(int64(carID))
+	userID, _ := r.Context().Value("userID").(uint64)
+
+	cars, err := uh.usersUcase.SelectCarByID(int64(carID), userID)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
 	body, err := json.Marshal(cars)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't marshal data"}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -700,6 +850,7 @@ func (uh *UsersHandler) GetCarByID(w http.ResponseWriter, r *http.Request) {
 // @Success      200
 // @Failure      400  {object}  utils.Error
 // @Failure      401  {object}  utils.Error
+// @Failure      403  {object}  utils.Error
 // @Failure      404  {object}  utils.Error
 // @Failure      500  {object}  utils.Error
 // @Router       /garage/{id}/delete [post]
@@ -707,30 +858,9 @@ func (uh *UsersHandler) DeleteCar(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	carID, _ := strconv.ParseUint(vars["id"], 10, 64)
 
-	userID, ok := r.Context().Value("userID").(uint64)
-	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
-		return
-	}
-
-	car, err := uh.usersUcase.SelectCarByID(int64(carID))
+	err := uh.usersUcase.DeleteCarByID(int64(carID))
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
-		return
-	}
-
-	if car.Owner.VKID != userID {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "user has inappropriate status"}))
-		return
-	}
-
-	err = uh.usersUcase.DeleteCarByID(int64(carID))
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -758,16 +888,20 @@ func (uh *UsersHandler) ComplainUser(w http.ResponseWriter, r *http.Request) {
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	req := &models.ComplaintReq{}
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't unmarshal data"}))
+		utils.WriteError(w, r, apperrors.Validation("can't unmarshal data"))
+		return
+	}
+
+	err = req.Validate()
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -777,8 +911,7 @@ func (uh *UsersHandler) ComplainUser(w http.ResponseWriter, r *http.Request) {
 		TargetID: int64(clubID),
 	})
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -805,16 +938,20 @@ func (uh *UsersHandler) ComplainCar(w http.ResponseWriter, r *http.Request) {
 
 	userID, ok := r.Context().Value("userID").(uint64)
 	if !ok {
-		w.WriteHeader(http.StatusUnauthorized)
-		w.Write(utils.JSONError(&utils.Error{Message: "you're unauthorized"}))
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
 		return
 	}
 
 	req := &models.ComplaintReq{}
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write(utils.JSONError(&utils.Error{Message: "can't unmarshal data"}))
+		utils.WriteError(w, r, apperrors.Validation("can't unmarshal data"))
+		return
+	}
+
+	err = req.Validate()
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -824,8 +961,133 @@ func (uh *UsersHandler) ComplainCar(w http.ResponseWriter, r *http.Request) {
 		TargetID: int64(clubID),
 	})
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write(utils.JSONError(&utils.Error{Message: err.Error()}))
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Logout godoc
+// @Summary      logout user
+// @Description  Handler for deleting the current session
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Success      200
+// @Failure      401  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /logout [post]
+func (uh *UsersHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	sessionValue, ok := r.Context().Value("sessionValue").(string)
+	if !ok {
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+		return
+	}
+
+	err := uh.usersUcase.DeleteSession(sessionValue)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "session_id",
+		Value:    "",
+		MaxAge:   -1,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+		HttpOnly: true,
+	})
+	w.WriteHeader(http.StatusOK)
+}
+
+// UserSessions godoc
+// @Summary      get active sessions
+// @Description  Handler for listing active sessions of the current user
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  []models.SessionInfo
+// @Failure      401  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /me/sessions [get]
+func (uh *UsersHandler) UserSessions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value("userID").(uint64)
+	if !ok {
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+		return
+	}
+	sessionValue, _ := r.Context().Value("sessionValue").(string)
+
+	userSessions, err := uh.usersUcase.GetUserSessions(userID, sessionValue)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	body, err := json.Marshal(userSessions)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// DeleteUserSession godoc
+// @Summary      revoke session
+// @Description  Handler for revoking one of the current user's sessions
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Session ID"
+// @Success      200
+// @Failure      401  {object}  utils.Error
+// @Failure      404  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /me/sessions/{id} [delete]
+func (uh *UsersHandler) DeleteUserSession(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	sessionID := vars["id"]
+
+	userID, ok := r.Context().Value("userID").(uint64)
+	if !ok {
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+		return
+	}
+
+	err := uh.usersUcase.DeleteUserSessionByID(userID, sessionID)
+	if err != nil {
+		utils.WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// DeleteAllUserSessions godoc
+// @Summary      logout everywhere
+// @Description  Handler for revoking all sessions of the current user, including the current one
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Success      200
+// @Failure      401  {object}  utils.Error
+// @Failure      500  {object}  utils.Error
+// @Router       /me/sessions [delete]
+func (uh *UsersHandler) DeleteAllUserSessions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value("userID").(uint64)
+	if !ok {
+		utils.WriteError(w, r, apperrors.Unauthorized("you're unauthorized"))
+		return
+	}
+
+	err := uh.usersUcase.DeleteAllUserSessions(userID)
+	if err != nil {
+		utils.WriteError(w, r, err)
 		return
 	}
 
@@ -1,19 +1,19 @@
 package users
 
-import "github.com/dantedoyl/car-life-api/internal/app/models"
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+)
 
 type IUsersRepository interface {
-	Insert(session *models.Session) error
-	SelectByValue(sessValue string) (*models.Session, error)
-	DeleteByValue(sessionValue string) error
-
 	InsertUser(user *models.User, car *models.CarCard) (*models.User, error)
 	SelectByID(userID uint64) (*models.User, error)
-	SelectCarByID(carID uint64) (*models.CarCard, error)
+	SelectByIDs(userIDs []uint64) ([]*models.User, error)
+	SelectCarByID(carID uint64, userID uint64) (*models.CarCard, error)
 	UpdateCar(car *models.CarCard) (*models.CarCard, error)
-	GetClubsByUserStatus(userID int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.ClubCard, error)
-	SelectCarByUserID(userID int64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.CarCard, error)
-	GetEventsByUserStatus(userID int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.EventCard, error)
+	GetClubsByUserStatus(userID int64, status string, page pagination.Page) ([]*models.ClubCard, *pagination.Cursor, error)
+	SelectCarByUserID(userID int64, page pagination.Page) ([]*models.CarCard, *pagination.Cursor, error)
+	GetEventsByUserStatus(userID int64, status string, page pagination.Page) ([]*models.EventCard, *pagination.Cursor, error)
 	InsertCar(car *models.CarCard) (*models.CarCard, error)
 	Update(user *models.User) (*models.User, error)
 	DeleteCarByID(carID int64) error
@@ -2,29 +2,21 @@ package users_repository
 
 import (
 	"database/sql"
-	"encoding/json"
-	"fmt"
+	"github.com/dantedoyl/car-life-api/internal/app/clients/database"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"github.com/dantedoyl/car-life-api/internal/app/users"
 	"github.com/lib/pq"
-	"github.com/tarantool/go-tarantool"
 	"strconv"
-	"sync"
 )
 
 type UsersRepository struct {
-	sqlConn       *sql.DB
-	tarantoolConn *tarantool.Connection
-	userSessions  map[string]*models.Session
+	sqlConn *sql.DB
 }
 
-var mtx sync.Mutex
-
-func NewUserRepository(connP *sql.DB, connT *tarantool.Connection) users.IUsersRepository {
+func NewUserRepository(connP *sql.DB) users.IUsersRepository {
 	return &UsersRepository{
-		sqlConn:       connP,
-		tarantoolConn: connT,
-		userSessions:  make(map[string]*models.Session),
+		sqlConn: connP,
 	}
 }
 
@@ -79,13 +71,35 @@ func (ur *UsersRepository) InsertCar(car *models.CarCard) (*models.CarCard, erro
 	return car, nil
 }
 
+func (ur *UsersRepository) SelectByIDs(userIDs []uint64) ([]*models.User, error) {
+	rows, err := ur.sqlConn.Query(
+		`SELECT vk_id, name, surname, avatar, tags, description, banned, updated_at from users
+				WHERE vk_id = ANY($1)`, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var found []*models.User
+	for rows.Next() {
+		user := &models.User{}
+		err = rows.Scan(&user.VKID, &user.Name, &user.Surname, &user.AvatarUrl, pq.Array(&user.Tags), &user.Description, &user.Banned, &user.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, user)
+	}
+	return found, rows.Err()
+}
+
 func (ur *UsersRepository) SelectByID(userID uint64) (*models.User, error) {
 	user := &models.User{}
 	err := ur.sqlConn.QueryRow(
-		`SELECT  vk_id, name, surname, avatar, tags, description from users
-				WHERE vk_id = $1`, userID).Scan(&user.VKID, &user.Name, &user.Surname, &user.AvatarUrl, pq.Array(&user.Tags), &user.Description)
+		`SELECT  vk_id, name, surname, avatar, tags, description, banned, updated_at from users
+				WHERE vk_id = $1`, userID).Scan(&user.VKID, &user.Name, &user.Surname, &user.AvatarUrl, pq.Array(&user.Tags), &user.Description, &user.Banned, &user.UpdatedAt)
 	if err == sql.ErrNoRows {
-		return nil, nil
+		return nil, users.ErrUserNotFound
 	}
 
 	if err != nil {
@@ -115,86 +129,14 @@ func (ur *UsersRepository) SelectByID(userID uint64) (*models.User, error) {
 	return user, nil
 }
 
-func (ur *UsersRepository) Insert(session *models.Session) error {
-	data, err := json.Marshal(session)
-	if err != nil {
-		return err
-	}
-
-	dataStr := string(data)
-
-	_, err = ur.tarantoolConn.Insert("sessions", []interface{}{session.Value, dataStr})
-	if err != nil {
-		return err
-	}
-	//______________________________________
-	// map session
-	//defer mtx.Unlock()
-	//mtx.Lock()
-	//ur.userSessions[session.Value] = session
-
-	return nil
-}
-
-func (ur *UsersRepository) SelectByValue(sessValue string) (*models.Session, error) {
-	resp, err := ur.tarantoolConn.Call("check_session", []interface{}{sessValue})
-	if err != nil {
-		return nil, err
-	}
-
-	data := resp.Data[0]
-	if data == nil {
-		return &models.Session{}, nil
-	}
-
-	sessionDataSlice, ok := data.([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("cannot cast data")
-	}
-
-	if sessionDataSlice[0] == nil {
-		return nil, fmt.Errorf("session not exist")
-	}
-
-	sessionData, ok := sessionDataSlice[1].(string)
-	if !ok {
-		return nil, fmt.Errorf("cannot cast to string")
-	}
-
-	sess := &models.Session{}
-	err = json.Unmarshal([]byte(sessionData), sess)
-	if err != nil {
-		return nil, err
-	}
-
-	//______________________________________
-	// map session
-	//sess, ok := ur.userSessions[sessValue]
-	//if !ok {
-	//	return nil, fmt.Errorf("no session")
-	//}
-
-	return sess, nil
-}
-
-func (ur *UsersRepository) DeleteByValue(sessionValue string) error {
-	_, err := ur.tarantoolConn.Delete("sessions", "primary", []interface{}{sessionValue})
-	if err != nil {
-		return err
-	}
-
-	//______________________________________
-	// map session
-	//delete(ur.userSessions, sessionValue)
-
-	return nil
-}
-
-func (ur *UsersRepository) SelectCarByID(carID uint64) (*models.CarCard, error) {
+func (ur *UsersRepository) SelectCarByID(carID uint64, userID uint64) (*models.CarCard, error) {
 	car := &models.CarCard{}
 	err := ur.sqlConn.QueryRow(
 		`SELECT id, owner_id, brand, model,date,description, avatar, body, engine, horse_power, name FROM cars
-				WHERE id = $1`, carID).Scan(&car.ID, &car.Owner.VKID, &car.Brand, &car.Model, &car.Date, &car.Description, &car.AvatarUrl, &car.Body, &car.Engine, &car.HorsePower, &car.Name)
+				WHERE id = $1 and `+database.Visible("cars", 2), carID, userID).Scan(&car.ID, &car.Owner.VKID, &car.Brand, &car.Model, &car.Date, &car.Description, &car.AvatarUrl, &car.Body, &car.Engine, &car.HorsePower, &car.Name)
+	if err == sql.ErrNoRows {
+		return nil, users.ErrCarNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -220,6 +162,9 @@ func (ur *UsersRepository) UpdateCar(car *models.CarCard) (*models.CarCard, erro
 		car.Description,
 		car.Date,
 		car.Body, car.Engine, car.HorsePower, car.Name).Scan(&car.ID, &car.Owner.VKID, &car.Brand, &car.Model, &car.Date, &car.Description, &car.AvatarUrl, &car.Body, &car.Engine, &car.HorsePower, &car.Name)
+	if err == sql.ErrNoRows {
+		return nil, users.ErrCarNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -234,140 +179,135 @@ func (ur *UsersRepository) UpdateCar(car *models.CarCard) (*models.CarCard, erro
 	return car, nil
 }
 
-func (ur *UsersRepository) GetClubsByUserStatus(userID int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.ClubCard, error) {
+func (ur *UsersRepository) GetClubsByUserStatus(userID int64, status string, page pagination.Page) ([]*models.ClubCard, *pagination.Cursor, error) {
 	var clubs []*models.ClubCard
 	ind := 3
 	var values []interface{}
 	values = append(values, status, userID)
-	q := `SELECT c.id, c.name, c.tags, c.participants_count, c.subscribers_count, c.avatar from users_clubs as uc inner join clubs as c on c.id = uc.club_id WHERE uc.status = $1 and uc.user_id = $2 `
-
-	if idGt != nil {
-		q += ` AND c.id > $` + strconv.Itoa(ind)
-		values = append(values, idGt)
-		ind++
-	}
+	q := `SELECT c.id, c.name, c.tags, c.participants_count, c.subscribers_count, c.avatar from users_clubs as uc inner join clubs as c on c.id = uc.club_id WHERE ` + clubStatusCondition(status) + ` and uc.user_id = $2 and c.hidden = false `
 
-	if idLte != nil {
-		q += ` AND c.id <= $` + strconv.Itoa(ind)
-		values = append(values, idLte)
-		ind++
+	if page.After != nil {
+		q += ` AND (c.name, c.id) < ($` + strconv.Itoa(ind) + `, $` + strconv.Itoa(ind+1) + `)`
+		values = append(values, page.After.Key, page.After.ID)
+		ind += 2
 	}
 
-	if limit != nil {
-		q += ` LIMIT $` + strconv.Itoa(ind)
-		values = append(values, limit)
-	}
-
-	q += ` ORDER BY name desc`
+	q += ` ORDER BY c.name desc, c.id desc LIMIT $` + strconv.Itoa(ind)
+	values = append(values, page.FetchLimit())
 	rows, err := ur.sqlConn.Query(q, values...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rows.Close()
 
+	var last, next *pagination.Cursor
 	for rows.Next() {
 		club := &models.ClubCard{}
 		err = rows.Scan(&club.ID, &club.Name, pq.Array(&club.Tags), &club.ParticipantsCount, &club.SubscribersCount, &club.AvatarUrl)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if page.Full(len(clubs)) {
+			next = last
+			break
 		}
 		clubs = append(clubs, club)
+		last = &pagination.Cursor{Key: club.Name, ID: club.ID}
 	}
-	return clubs, nil
+	return clubs, next, rows.Err()
 }
 
-func (ur *UsersRepository) SelectCarByUserID(userID int64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.CarCard, error) {
+func (ur *UsersRepository) SelectCarByUserID(userID int64, page pagination.Page) ([]*models.CarCard, *pagination.Cursor, error) {
 	var cars []*models.CarCard
 	ind := 2
 	var values []interface{}
 	values = append(values, userID)
-	q := `SELECT c.id, c.owner_id, c.brand, c.model,c.date,c.description, c.avatar, c.body, c.engine, c.horse_power, c.name from cars as c WHERE c.owner_id = $1`
+	q := `SELECT c.id, c.owner_id, c.brand, c.model,c.date,c.description, c.avatar, c.body, c.engine, c.horse_power, coalesce(c.name, '') from cars as c WHERE c.owner_id = $1 and c.hidden = false`
 
-	if idGt != nil {
-		q += ` AND c.id > $` + strconv.Itoa(ind)
-		values = append(values, idGt)
-		ind++
+	if page.After != nil {
+		q += ` AND (coalesce(c.name, ''), c.id) < ($` + strconv.Itoa(ind) + `, $` + strconv.Itoa(ind+1) + `)`
+		values = append(values, page.After.Key, page.After.ID)
+		ind += 2
 	}
 
-	if idLte != nil {
-		q += ` AND c.id <= $` + strconv.Itoa(ind)
-		values = append(values, idLte)
-		ind++
-	}
-
-	if limit != nil {
-		q += ` LIMIT $` + strconv.Itoa(ind)
-		values = append(values, limit)
-	}
-
-	q += ` ORDER BY c.name desc`
+	q += ` ORDER BY coalesce(c.name, '') desc, c.id desc LIMIT $` + strconv.Itoa(ind)
+	values = append(values, page.FetchLimit())
 	rows, err := ur.sqlConn.Query(q, values...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rows.Close()
 
+	var last, next *pagination.Cursor
 	for rows.Next() {
 		car := &models.CarCard{}
 		err = rows.Scan(&car.ID, &car.Owner.VKID, &car.Brand, &car.Model, &car.Date, &car.Description, &car.AvatarUrl, &car.Body, &car.Engine, &car.HorsePower, &car.Name)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if page.Full(len(cars)) {
+			next = last
+			break
 		}
 		cars = append(cars, car)
+		last = &pagination.Cursor{Key: car.Name, ID: car.ID}
 	}
-	return cars, nil
+	return cars, next, rows.Err()
 }
 
-func (ur *UsersRepository) GetEventsByUserStatus(userID int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.EventCard, error) {
+func (ur *UsersRepository) GetEventsByUserStatus(userID int64, status string, page pagination.Page) ([]*models.EventCard, *pagination.Cursor, error) {
 	var events []*models.EventCard
 	ind := 3
 	var values []interface{}
 	values = append(values, status, userID)
-	q := `SELECT e.id, e.name, e.event_date, e.latitude, e.longitude, e.avatar from users_events as ue inner join events as e on e.id = ue.event_id WHERE ue.status = $1 and ue.user_id = $2`
-
-	if idGt != nil {
-		q += ` AND e.id > $` + strconv.Itoa(ind)
-		values = append(values, idGt)
-		ind++
-	}
-
-	if idLte != nil {
-		q += ` AND e.id <= $` + strconv.Itoa(ind)
-		values = append(values, idLte)
-		ind++
-	}
+	q := `SELECT e.id, e.name, e.event_date, e.latitude, e.longitude, e.avatar from users_events as ue inner join events as e on e.id = ue.event_id WHERE ue.status = $1 and ue.user_id = $2 and e.hidden = false`
 
-	if limit != nil {
-		q += ` LIMIT $` + strconv.Itoa(ind)
-		values = append(values, limit)
+	if page.After != nil {
+		eventDate, err := page.After.Time()
+		if err != nil {
+			return nil, nil, err
+		}
+		q += ` AND (e.event_date, e.id) < ($` + strconv.Itoa(ind) + `, $` + strconv.Itoa(ind+1) + `)`
+		values = append(values, eventDate, page.After.ID)
+		ind += 2
 	}
 
-	q += ` ORDER BY e.event_date desc`
+	q += ` ORDER BY e.event_date desc, e.id desc LIMIT $` + strconv.Itoa(ind)
+	values = append(values, page.FetchLimit())
 	rows, err := ur.sqlConn.Query(q, values...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rows.Close()
 
+	var last, next *pagination.Cursor
 	for rows.Next() {
 		event := &models.EventCard{}
 		err = rows.Scan(&event.ID, &event.Name, &event.EventDate,
 			&event.Latitude, &event.Longitude, &event.AvatarUrl)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if page.Full(len(events)) {
+			next = last
+			break
 		}
 		events = append(events, event)
+		last = &pagination.Cursor{Key: pagination.TimeKey(event.EventDate), ID: event.ID}
 	}
-	return events, nil
+	return events, next, rows.Err()
 }
 
 func (ur *UsersRepository) Update(user *models.User) (*models.User, error) {
 	err := ur.sqlConn.QueryRow(`UPDATE users SET tags = $1, description = $2 WHERE vk_id = $3
-RETURNING vk_id, name, surname, avatar, tags, description`, pq.Array(user.Tags), user.Description, user.VKID).Scan(
-		&user.VKID, &user.Name, &user.Surname, &user.AvatarUrl, pq.Array(&user.Tags), &user.Description)
+RETURNING vk_id, name, surname, avatar, tags, description, updated_at`, pq.Array(user.Tags), user.Description, user.VKID).Scan(
+		&user.VKID, &user.Name, &user.Surname, &user.AvatarUrl, pq.Array(&user.Tags), &user.Description, &user.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, users.ErrUserNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -391,3 +331,13 @@ func (ur *UsersRepository) ComplainByID(target string, complaint models.Complain
 	return nil
 }
 
+
+// clubStatusCondition matches users_clubs rows with the status in $1. A moderator
+// is a participant of the club too, so the clubs they moderate are among their
+// participant clubs.
+func clubStatusCondition(status string) string {
+	if status == "participant" {
+		return `uc.status IN ($1, 'moderator')`
+	}
+	return `uc.status = $1`
+}
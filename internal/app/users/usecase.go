@@ -1,24 +1,37 @@
 package users
 
 import (
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
 	"mime/multipart"
 )
 
+var (
+	ErrUserNotFound = apperrors.NotFound("user not found")
+	ErrCarNotFound  = apperrors.NotFound("car not found")
+)
+
 type IUsersUsecase interface {
-	CreateSession(sess *models.Session) error
+	StartSession(userID uint64, userAgent string, ip string) (*models.Session, *models.RefreshToken, error)
+	RefreshSession(refreshToken string, userAgent string, ip string) (*models.Session, *models.RefreshToken, error)
 	GetSession(sessValue string) (*models.Session, error)
 	DeleteSession(sessionValue string) error
 	CheckSession(sessValue string) (*models.Session, error)
+	GetUserSessions(userID uint64, currentSessValue string) ([]*models.SessionInfo, error)
+	DeleteUserSessionByID(userID uint64, sessionID string) error
+	DeleteAllUserSessions(userID uint64) error
 
 	Create(user *models.User, car *models.CarCard) (*models.User, error)
 	GetByID(vkID uint64) (*models.User, error)
-	GetClubsByUserStatus(userID int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.ClubCard, error)
+	// GetByIDs returns the users that exist among vkIDs, in no particular order.
+	GetByIDs(vkIDs []uint64) ([]*models.User, error)
+	GetClubsByUserStatus(userID int64, status string, page pagination.Page) ([]*models.ClubCard, *pagination.Cursor, error)
 	UpdateAvatar(carID uint64, fileHeader *multipart.FileHeader) (*models.User, error)
 	AddNewUserCar(car *models.CarCard) (*models.CarCard, error)
-	SelectCarByUserID(userID int64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.CarCard, error)
-	SelectCarByID(carID int64) (*models.CarCard, error)
-	GetEventsByUserStatus(userID int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.EventCard, error)
+	SelectCarByUserID(userID int64, page pagination.Page) ([]*models.CarCard, *pagination.Cursor, error)
+	SelectCarByID(carID int64, userID uint64) (*models.CarCard, error)
+	GetEventsByUserStatus(userID int64, status string, page pagination.Page) ([]*models.EventCard, *pagination.Cursor, error)
 	UpdateUserInfo(user *models.User) (*models.User, error)
 	DeleteCarByID(carID int64) error
 	ComplainByID(target string, complaint models.Complaint) error
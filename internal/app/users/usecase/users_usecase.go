@@ -3,18 +3,39 @@ package usecase
 import (
 	"github.com/dantedoyl/car-life-api/internal/app/clients/filesystem"
 	"github.com/dantedoyl/car-life-api/internal/app/models"
+	"github.com/dantedoyl/car-life-api/internal/app/pagination"
+	"github.com/dantedoyl/car-life-api/internal/app/sessions"
 	"github.com/dantedoyl/car-life-api/internal/app/users"
+	"github.com/google/uuid"
 	"mime/multipart"
+	"sort"
 	"time"
 )
 
+// lastSeenUpdateInterval limits how often a request rewrites the session just to bump last_seen_at.
+const lastSeenUpdateInterval = time.Minute
+
+// SessionConfig sets the lifetimes of the session pair.
+// AccessTTL is how long a fresh session lives, RefreshTTL is how long its refresh token
+// can be exchanged, and an active session is kept alive for at least SlidingWindow after
+// its last request.
+type SessionConfig struct {
+	AccessTTL     time.Duration
+	RefreshTTL    time.Duration
+	SlidingWindow time.Duration
+}
+
 type UsersUsecase struct {
-	usersRepo users.IUsersRepository
+	usersRepo     users.IUsersRepository
+	sessionStore  sessions.SessionStore
+	sessionConfig SessionConfig
 }
 
-func NewUsersUsecase(repo users.IUsersRepository) users.IUsersUsecase {
+func NewUsersUsecase(repo users.IUsersRepository, sessionStore sessions.SessionStore, sessionConfig SessionConfig) users.IUsersUsecase {
 	return &UsersUsecase{
-		usersRepo: repo,
+		usersRepo:     repo,
+		sessionStore:  sessionStore,
+		sessionConfig: sessionConfig,
 	}
 }
 
@@ -32,24 +53,66 @@ func (uu *UsersUsecase) GetByID(vkID uint64) (*models.User, error) {
 		return nil, err
 	}
 
-	if user == nil {
-		return nil, nil
+	return user, nil
+}
+
+func (uu *UsersUsecase) GetByIDs(vkIDs []uint64) ([]*models.User, error) {
+	return uu.usersRepo.SelectByIDs(vkIDs)
+}
+
+func (uu *UsersUsecase) StartSession(userID uint64, userAgent string, ip string) (*models.Session, *models.RefreshToken, error) {
+	return uu.issueSessionPair(userID, uuid.New().String(), userAgent, ip)
+}
+
+func (uu *UsersUsecase) RefreshSession(refreshToken string, userAgent string, ip string) (*models.Session, *models.RefreshToken, error) {
+	token, err := uu.sessionStore.UseRefreshToken(refreshToken)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return user, nil
+	// A token that was already exchanged means it leaked: revoke the whole chain.
+	if token.Used {
+		err = uu.sessionStore.DeleteFamily(token.FamilyID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return nil, nil, sessions.ErrRefreshTokenReused
+	}
+
+	if token.ExpiresAt.Before(time.Now()) {
+		return nil, nil, sessions.ErrRefreshTokenExpired
+	}
+
+	err = uu.sessionStore.DeleteByValue(token.SessionValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return uu.issueSessionPair(token.UserID, token.FamilyID, userAgent, ip)
 }
 
-func (uu *UsersUsecase) CreateSession(sess *models.Session) error {
-	err := uu.usersRepo.Insert(sess)
+func (uu *UsersUsecase) issueSessionPair(userID uint64, familyID string, userAgent string, ip string) (*models.Session, *models.RefreshToken, error) {
+	sess := models.CreateSession(userID, familyID, uu.sessionConfig.AccessTTL)
+	sess.UserAgent = userAgent
+	sess.IP = ip
+
+	err := uu.sessionStore.Insert(sess)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	token := models.CreateRefreshToken(sess, uu.sessionConfig.RefreshTTL)
+	err = uu.sessionStore.InsertRefreshToken(token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sess, token, nil
 }
 
 func (uu *UsersUsecase) GetSession(sessValue string) (*models.Session, error) {
-	sess, err := uu.usersRepo.SelectByValue(sessValue)
+	sess, err := uu.sessionStore.SelectByValue(sessValue)
 	if err != nil {
 		return nil, err
 	}
@@ -58,16 +121,22 @@ func (uu *UsersUsecase) GetSession(sessValue string) (*models.Session, error) {
 }
 
 func (uu *UsersUsecase) DeleteSession(sessionValue string) error {
-	if _, err := uu.GetSession(sessionValue); err != nil {
+	sess, err := uu.GetSession(sessionValue)
+	if err != nil {
 		return err
 	}
 
-	err := uu.usersRepo.DeleteByValue(sessionValue)
-	if err != nil {
-		return err
+	return uu.deleteSession(sess)
+}
+
+// deleteSession drops the session together with its refresh token family,
+// so a logged out session cannot be brought back with /session/refresh.
+func (uu *UsersUsecase) deleteSession(sess *models.Session) error {
+	if sess.FamilyID == "" {
+		return uu.sessionStore.DeleteByValue(sess.Value)
 	}
 
-	return nil
+	return uu.sessionStore.DeleteFamily(sess.FamilyID)
 }
 
 func (uu *UsersUsecase) CheckSession(sessValue string) (*models.Session, error) {
@@ -76,20 +145,82 @@ func (uu *UsersUsecase) CheckSession(sessValue string) (*models.Session, error)
 		return nil, err
 	}
 
-	if sess.ExpiresAt.Before(time.Now()) {
-		err := uu.DeleteSession(sessValue)
+	now := time.Now()
+	if sess.ExpiresAt.Before(now) {
+		// Only the access session is gone, its refresh token may still be exchanged.
+		err := uu.sessionStore.DeleteByValue(sessValue)
 		if err != nil {
 			return nil, err
 		}
 
-		return nil, err
+		return nil, sessions.ErrSessionExpired
+	}
+
+	if now.Sub(sess.LastSeenAt) > lastSeenUpdateInterval {
+		sess.LastSeenAt = now
+		if sess.ExpiresAt.Sub(now) < uu.sessionConfig.SlidingWindow {
+			sess.ExpiresAt = now.Add(uu.sessionConfig.SlidingWindow)
+		}
+		err = uu.sessionStore.Update(sess)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return sess, nil
 }
 
+func (uu *UsersUsecase) GetUserSessions(userID uint64, currentSessValue string) ([]*models.SessionInfo, error) {
+	userSessions, err := uu.sessionStore.SelectByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	infos := make([]*models.SessionInfo, 0, len(userSessions))
+	for _, sess := range userSessions {
+		if sess.ExpiresAt.Before(now) {
+			continue
+		}
+		infos = append(infos, &models.SessionInfo{
+			ID:         sess.ID,
+			CreatedAt:  sess.CreatedAt,
+			LastSeenAt: sess.LastSeenAt,
+			ExpiresAt:  sess.ExpiresAt,
+			UserAgent:  sess.UserAgent,
+			IP:         sess.IP,
+			Current:    sess.Value == currentSessValue,
+		})
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].LastSeenAt.After(infos[j].LastSeenAt)
+	})
+
+	return infos, nil
+}
+
+func (uu *UsersUsecase) DeleteUserSessionByID(userID uint64, sessionID string) error {
+	userSessions, err := uu.sessionStore.SelectByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	for _, sess := range userSessions {
+		if sess.ID == sessionID {
+			return uu.deleteSession(sess)
+		}
+	}
+
+	return sessions.ErrSessionNotFound
+}
+
+func (uu *UsersUsecase) DeleteAllUserSessions(userID uint64) error {
+	return uu.sessionStore.DeleteByUserID(userID)
+}
+
 func (uu *UsersUsecase) UpdateAvatar(carID uint64, fileHeader *multipart.FileHeader) (*models.User, error) {
-	car, err := uu.usersRepo.SelectCarByID(carID)
+	car, err := uu.usersRepo.SelectCarByID(carID, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -123,16 +254,16 @@ func (uu *UsersUsecase) UpdateAvatar(carID uint64, fileHeader *multipart.FileHea
 	return user, nil
 }
 
-func (uu *UsersUsecase) GetClubsByUserStatus(userID int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.ClubCard, error) {
-	return uu.usersRepo.GetClubsByUserStatus(userID, status, idGt, idLte, limit)
+func (uu *UsersUsecase) GetClubsByUserStatus(userID int64, status string, page pagination.Page) ([]*models.ClubCard, *pagination.Cursor, error) {
+	return uu.usersRepo.GetClubsByUserStatus(userID, status, page)
 }
 
-func (uu *UsersUsecase) SelectCarByUserID(userID int64, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.CarCard, error) {
-	return uu.usersRepo.SelectCarByUserID(userID, idGt, idLte, limit)
+func (uu *UsersUsecase) SelectCarByUserID(userID int64, page pagination.Page) ([]*models.CarCard, *pagination.Cursor, error) {
+	return uu.usersRepo.SelectCarByUserID(userID, page)
 }
 
-func (uu *UsersUsecase) GetEventsByUserStatus(userID int64, status string, idGt *uint64, idLte *uint64, limit *uint64) ([]*models.EventCard, error) {
-	return uu.usersRepo.GetEventsByUserStatus(userID, status, idGt, idLte, limit)
+func (uu *UsersUsecase) GetEventsByUserStatus(userID int64, status string, page pagination.Page) ([]*models.EventCard, *pagination.Cursor, error) {
+	return uu.usersRepo.GetEventsByUserStatus(userID, status, page)
 }
 
 func (uu *UsersUsecase) AddNewUserCar(car *models.CarCard) (*models.CarCard, error) {
@@ -143,8 +274,8 @@ func (uu *UsersUsecase) UpdateUserInfo(user *models.User) (*models.User, error)
 	return uu.usersRepo.Update(user)
 }
 
-func (uu *UsersUsecase) SelectCarByID(carID int64) (*models.CarCard, error) {
-	return uu.usersRepo.SelectCarByID(uint64(carID))
+func (uu *UsersUsecase) SelectCarByID(carID int64, userID uint64) (*models.CarCard, error) {
+	return uu.usersRepo.SelectCarByID(uint64(carID), userID)
 }
 
 func (uu *UsersUsecase) DeleteCarByID(carID int64) error {
@@ -1,10 +1,64 @@
 package utils
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"net"
+	"net/http"
+	"strings"
+)
 
 type Error struct {
-	HttpError int    `json:"-"`
-	Message   string `json:"message"`
+	HttpError int                    `json:"-"`
+	Code      string                 `json:"code"`
+	Message   string                 `json:"message"`
+	Fields    []apperrors.FieldError `json:"fields,omitempty"`
+}
+
+var statusByCode = map[apperrors.Code]int{
+	apperrors.CodeValidation:   http.StatusBadRequest,
+	apperrors.CodeUnauthorized: http.StatusUnauthorized,
+	apperrors.CodeForbidden:    http.StatusForbidden,
+	apperrors.CodeNotFound:     http.StatusNotFound,
+	apperrors.CodeConflict:     http.StatusConflict,
+	apperrors.CodeRateLimited:  http.StatusTooManyRequests,
+}
+
+// WriteError writes err as a JSON error with the status that matches its apperrors code.
+// Any other error is logged with the request logger and reported as 500 without its text,
+// so that database and client errors don't leak to the response.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	var appErr *apperrors.Error
+	if !errors.As(err, &appErr) {
+		logger.FromContext(r.Context()).Error("request failed", "error", err)
+		appErr = &apperrors.Error{Code: apperrors.CodeInternal, Message: "internal server error"}
+	}
+
+	status, ok := statusByCode[appErr.Code]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(JSONError(&Error{HttpError: status, Code: string(appErr.Code), Message: appErr.Error(), Fields: appErr.Fields}))
+}
+
+// WriteCreated answers 201 Created with v as the JSON body and location, the URL of the new resource, in the Location header.
+func WriteCreated(w http.ResponseWriter, r *http.Request, location string, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", location)
+	w.WriteHeader(http.StatusCreated)
+	w.Write(body)
 }
 
 func JSONError(error *Error) []byte {
@@ -14,3 +68,101 @@ func JSONError(error *Error) []byte {
 	}
 	return jsonError
 }
+
+// TrustedProxies are the networks of the reverse proxies in front of the server.
+// Only they are believed about the client address in X-Forwarded-For.
+type TrustedProxies []*net.IPNet
+
+// NewTrustedProxies parses proxies, each an IP or a CIDR. Config.Validate rejects
+// anything else, so an entry that doesn't parse is skipped.
+func NewTrustedProxies(proxies []string) TrustedProxies {
+	trusted := make(TrustedProxies, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				continue
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				bits = 8 * net.IPv4len
+			}
+			trusted = append(trusted, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			continue
+		}
+		trusted = append(trusted, network)
+	}
+	return trusted
+}
+
+func (tp TrustedProxies) contains(ip net.IP) bool {
+	for _, network := range tp {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the address of the client of r. The peer address is used as is unless
+// it is a trusted proxy. Then X-Forwarded-For is walked from the right, where each proxy
+// appends the address it got the request from, and the first hop that isn't a trusted
+// proxy is the client; everything left of it could have been sent by the client itself.
+func (tp TrustedProxies) ClientIP(r *http.Request) string {
+	client := remoteHost(r.RemoteAddr)
+	if !tp.contains(net.ParseIP(client)) {
+		return client
+	}
+
+	var hops []string
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		for _, hop := range strings.Split(header, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip := net.ParseIP(hops[i])
+		if ip == nil {
+			// A proxy wouldn't append that, so the hops from here on are made up.
+			break
+		}
+		client = ip.String()
+		if !tp.contains(ip) {
+			break
+		}
+	}
+	return client
+}
+
+type clientIPKey struct{}
+
+// WithClientIP stores the client address resolved by the outermost middleware for ClientIP.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+// ClientIP returns the client address the middleware stored in the context of r,
+// or the peer address of a request that didn't go through it.
+func ClientIP(r *http.Request) string {
+	if ip, ok := r.Context().Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	return remoteHost(r.RemoteAddr)
+}
+
+func remoteHost(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTrustedProxiesClientIP(t *testing.T) {
+	proxies := NewTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1", "::1", "not a proxy"})
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  []string
+		want       string
+	}{
+		{"direct client", "203.0.113.7:51000", nil, "203.0.113.7"},
+		{"direct client forging the header", "203.0.113.7:51000", []string{"1.2.3.4"}, "203.0.113.7"},
+		{"behind a proxy", "10.0.0.2:443", []string{"203.0.113.7"}, "203.0.113.7"},
+		{"proxy without the header", "10.0.0.2:443", nil, "10.0.0.2"},
+		{"forged hops left of the client", "10.0.0.2:443", []string{"1.2.3.4, 203.0.113.7"}, "203.0.113.7"},
+		{"chain of proxies", "192.168.1.1:443", []string{"1.2.3.4, 203.0.113.7, 10.1.2.3"}, "203.0.113.7"},
+		{"header repeated", "10.0.0.2:443", []string{"1.2.3.4", "203.0.113.7, 10.1.2.3"}, "203.0.113.7"},
+		{"only proxies", "10.0.0.2:443", []string{"10.9.9.9, 10.1.2.3"}, "10.9.9.9"},
+		{"garbage hop", "10.0.0.2:443", []string{"203.0.113.7, garbage, 10.1.2.3"}, "10.1.2.3"},
+		{"ipv6 proxy", "[::1]:443", []string{"2001:db8::1"}, "2001:db8::1"},
+		{"remote address without port", "203.0.113.7", nil, "203.0.113.7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for _, header := range tt.forwarded {
+				r.Header.Add("X-Forwarded-For", header)
+			}
+
+			if got := proxies.ClientIP(r); got != tt.want {
+				t.Fatalf("ClientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientIPWithoutProxies(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.RemoteAddr = "203.0.113.7:51000"
+	r.Header.Set("X-Forwarded-For", "1.2.3.4")
+	r.Header.Set("X-Real-IP", "1.2.3.4")
+
+	if got := NewTrustedProxies(nil).ClientIP(r); got != "203.0.113.7" {
+		t.Fatalf("ClientIP() = %q, want the peer address", got)
+	}
+	if got := ClientIP(r); got != "203.0.113.7" {
+		t.Fatalf("ClientIP() without the middleware = %q, want the peer address", got)
+	}
+
+	r = r.WithContext(WithClientIP(r.Context(), "198.51.100.1"))
+	if got := ClientIP(r); got != "198.51.100.1" {
+		t.Fatalf("ClientIP() = %q, want the address stored by the middleware", got)
+	}
+}
@@ -0,0 +1,72 @@
+// Package validation checks request payloads before they reach the usecases
+// and collects every problem as a field-level error.
+package validation
+
+import (
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MaxNameLength        = 100
+	MaxDescriptionLength = 2000
+	MaxPostTextLength    = 4000
+	MaxCommentLength     = 1000
+	MaxTagsCount         = 20
+)
+
+type Validator struct {
+	fields []apperrors.FieldError
+}
+
+func New() *Validator {
+	return &Validator{}
+}
+
+// Check records message for field unless ok. Only the first problem of a field is kept.
+func (v *Validator) Check(ok bool, field string, message string) {
+	if ok {
+		return
+	}
+	for _, f := range v.fields {
+		if f.Field == field {
+			return
+		}
+	}
+	v.fields = append(v.fields, apperrors.FieldError{Field: field, Error: message})
+}
+
+func (v *Validator) Required(field string, value string) {
+	v.Check(strings.TrimSpace(value) != "", field, "is required")
+}
+
+func (v *Validator) MaxLength(field string, value string, max int) {
+	v.Check(utf8.RuneCountInString(value) <= max, field, "must be at most "+strconv.Itoa(max)+" characters long")
+}
+
+func (v *Validator) Latitude(field string, value float32) {
+	v.Check(value >= -90 && value <= 90, field, "must be between -90 and 90")
+}
+
+func (v *Validator) Longitude(field string, value float32) {
+	v.Check(value >= -180 && value <= 180, field, "must be between -180 and 180")
+}
+
+func (v *Validator) Future(field string, value time.Time) {
+	v.Check(value.After(time.Now()), field, "must be in the future")
+}
+
+func (v *Validator) NotFuture(field string, value time.Time) {
+	v.Check(!value.After(time.Now()), field, "must not be in the future")
+}
+
+// Err returns an apperrors validation error with all recorded fields, or nil if there are none.
+func (v *Validator) Err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return apperrors.InvalidFields(v.fields)
+}
@@ -0,0 +1,97 @@
+package validation
+
+import (
+	"errors"
+	"github.com/dantedoyl/car-life-api/internal/app/apperrors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func fieldErrors(t *testing.T, err error) []apperrors.FieldError {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	var appErr *apperrors.Error
+	if !errors.As(err, &appErr) || appErr.Code != apperrors.CodeValidation {
+		t.Fatalf("Err() = %v, want a validation error", err)
+	}
+	return appErr.Fields
+}
+
+func TestValidatorRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(v *Validator)
+		want  string
+	}{
+		{"required", func(v *Validator) { v.Required("f", "x") }, ""},
+		{"required empty", func(v *Validator) { v.Required("f", "") }, "is required"},
+		{"required blank", func(v *Validator) { v.Required("f", " \t\n") }, "is required"},
+		{"max length at the limit", func(v *Validator) { v.MaxLength("f", strings.Repeat("a", 3), 3) }, ""},
+		{"max length over the limit", func(v *Validator) { v.MaxLength("f", strings.Repeat("a", 4), 3) }, "must be at most 3 characters long"},
+		{"max length counts runes", func(v *Validator) { v.MaxLength("f", "ёжик", 4) }, ""},
+		{"latitude", func(v *Validator) { v.Latitude("f", 55.75) }, ""},
+		{"latitude bounds", func(v *Validator) { v.Latitude("f", -90); v.Latitude("f", 90) }, ""},
+		{"latitude too low", func(v *Validator) { v.Latitude("f", -90.5) }, "must be between -90 and 90"},
+		{"latitude too high", func(v *Validator) { v.Latitude("f", 90.5) }, "must be between -90 and 90"},
+		{"longitude bounds", func(v *Validator) { v.Longitude("f", -180); v.Longitude("f", 180) }, ""},
+		{"longitude too low", func(v *Validator) { v.Longitude("f", -180.5) }, "must be between -180 and 180"},
+		{"longitude too high", func(v *Validator) { v.Longitude("f", 180.5) }, "must be between -180 and 180"},
+		{"future", func(v *Validator) { v.Future("f", time.Now().Add(time.Hour)) }, ""},
+		{"future in the past", func(v *Validator) { v.Future("f", time.Now().Add(-time.Hour)) }, "must be in the future"},
+		{"not future", func(v *Validator) { v.NotFuture("f", time.Now().Add(-time.Hour)) }, ""},
+		{"not future in the future", func(v *Validator) { v.NotFuture("f", time.Now().Add(time.Hour)) }, "must not be in the future"},
+		{"check", func(v *Validator) { v.Check(true, "f", "broken") }, ""},
+		{"check failed", func(v *Validator) { v.Check(false, "f", "broken") }, "broken"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := New()
+			tt.check(v)
+
+			fields := fieldErrors(t, v.Err())
+			if tt.want == "" {
+				if fields != nil {
+					t.Fatalf("fields = %+v, want none", fields)
+				}
+				return
+			}
+			want := []apperrors.FieldError{{Field: "f", Error: tt.want}}
+			if !reflect.DeepEqual(fields, want) {
+				t.Fatalf("fields = %+v, want %+v", fields, want)
+			}
+		})
+	}
+}
+
+func TestValidatorKeepsFirstErrorOfField(t *testing.T) {
+	v := New()
+	v.Required("name", "")
+	v.MaxLength("name", "", 0)
+	v.Check(false, "name", "is taken")
+	v.Check(false, "tags", "is broken")
+
+	want := []apperrors.FieldError{
+		{Field: "name", Error: "is required"},
+		{Field: "tags", Error: "is broken"},
+	}
+	if fields := fieldErrors(t, v.Err()); !reflect.DeepEqual(fields, want) {
+		t.Fatalf("fields = %+v, want %+v", fields, want)
+	}
+}
+
+func TestValidatorErrIsValidation(t *testing.T) {
+	v := New()
+	if err := v.Err(); err != nil {
+		t.Fatalf("Err() of an empty validator = %v, want nil", err)
+	}
+
+	v.Required("name", "")
+	if err := v.Err(); !errors.Is(err, apperrors.ErrValidation) {
+		t.Fatalf("Err() = %v, want it to match apperrors.ErrValidation", err)
+	}
+}
@@ -1,34 +1,30 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
 	_ "github.com/dantedoyl/car-life-api/docs"
-	"github.com/dantedoyl/car-life-api/internal/app/clients/database"
-	"github.com/dantedoyl/car-life-api/internal/app/clients/vk"
-	clubs_delivery "github.com/dantedoyl/car-life-api/internal/app/clubs/delivery/http"
-	clubs_repository "github.com/dantedoyl/car-life-api/internal/app/clubs/repository/postgres"
-	clubs_usecase "github.com/dantedoyl/car-life-api/internal/app/clubs/usecase"
-	events_delivery "github.com/dantedoyl/car-life-api/internal/app/events/delivery/http"
-	events_repository "github.com/dantedoyl/car-life-api/internal/app/events/repository/postgres"
-	events_usecase "github.com/dantedoyl/car-life-api/internal/app/events/usecase"
-	events_posts_delivery "github.com/dantedoyl/car-life-api/internal/app/events_posts/delivery/http"
-	events_posts_repository "github.com/dantedoyl/car-life-api/internal/app/events_posts/repository/postgres"
-	events_posts_usecase "github.com/dantedoyl/car-life-api/internal/app/events_posts/usecase"
-	"github.com/dantedoyl/car-life-api/internal/app/middleware"
-	mini_events_delivery "github.com/dantedoyl/car-life-api/internal/app/mini_events/delivery/http"
-	mini_events_repository "github.com/dantedoyl/car-life-api/internal/app/mini_events/repository/postgres"
-	mini_events_usecase "github.com/dantedoyl/car-life-api/internal/app/mini_events/usecase"
-	users_delivery "github.com/dantedoyl/car-life-api/internal/app/users/delivery/http"
-	users_repository "github.com/dantedoyl/car-life-api/internal/app/users/repository/postgres"
-	users_usecase "github.com/dantedoyl/car-life-api/internal/app/users/usecase"
-	"github.com/gorilla/mux"
-	httpSwagger "github.com/swaggo/http-swagger"
-	"github.com/tarantool/go-tarantool"
+	"github.com/dantedoyl/car-life-api/internal/app/config"
 	"log"
-	"net/http"
-	"time"
+	"os"
+	"strings"
 )
 
+type command struct {
+	name        string
+	description string
+	run         func(cfg *config.Config, args []string) error
+}
+
+var commands = []command{
+	{name: "serve", description: "serve the HTTP API (the default)", run: runServe},
+	{name: "migrate", description: "apply, roll back or list schema migrations: migrate up|down|status", run: runMigrate},
+	{name: "seed", description: "load demo users, cars, clubs and events", run: runSeed},
+	{name: "cleanup", description: "remove ended mini events, expired sessions and orphaned images", run: runCleanup},
+	{name: "recount", description: "recompute the member and event counters of clubs and events", run: runRecount},
+}
+
 // @title           Swagger Example API
 // @version         1.0
 // @description     API for CarLife application
@@ -36,79 +32,39 @@ import (
 // @host      localhost:8080
 // @BasePath  /api/v1
 func main() {
-	postgresDB, err := database.NewPostgres("host=localhost port=5432 user=postgres password=postgres dbname=car_life_api sslmode=disable")
-	if err != nil {
-		log.Fatal(err)
+	name, args := "serve", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
 	}
-	defer postgresDB.Close()
 
-	opts := tarantool.Opts{
-		User: "admin",
-		Pass: "pass",
+	var cmd *command
+	for i := range commands {
+		if commands[i].name == name {
+			cmd = &commands[i]
+		}
 	}
-	tarConn, err := tarantool.Connect("127.0.0.1:3301", opts)
-	if err != nil {
-		fmt.Println("baa: Connection refused:", err)
-		return
+	if cmd == nil {
+		usage()
+		os.Exit(2)
 	}
 
-	vkCl := vk.NewVKClient(
-		"0918a4f20918a4f20918a4f27909633217009180918a4f26b34f910640ea9466b2c60dd",
-		"1778a9046f1d83c8716dffe78116e0ce119cde5d96f7a2a8557299d0983bd349e91e13dea9cd920a2b5ed",
-	)
-
-	//________________________________
-	//session map
-	//var tarConn *tarantool.Connection = nil
-
-	userRepo := users_repository.NewUserRepository(postgresDB.GetDatabase(), tarConn)
-	userUcase := users_usecase.NewUsersUsecase(userRepo)
-	userHandler := users_delivery.NewUserssHandler(userUcase)
-
-	clubsRepo := clubs_repository.NewClubRepository(postgresDB.GetDatabase())
-	clubsUcase := clubs_usecase.NewClubsUsecase(clubsRepo)
-	clubsHandler := clubs_delivery.NewClubsHandler(clubsUcase, vkCl)
-
-	eventsRepo := events_repository.NewProductRepository(postgresDB.GetDatabase())
-	eventsUcase := events_usecase.NewEventsUsecase(eventsRepo)
-	eventHandler := events_delivery.NewEventsHandler(eventsUcase, clubsUcase, vkCl)
-
-	miniEventsRepo := mini_events_repository.NewMiniEventsRepository(postgresDB.GetDatabase())
-	miniEventsUcase := mini_events_usecase.NewMiniEventsUsecase(miniEventsRepo)
-	miniEventHandler := mini_events_delivery.NewMiniEventsHandler(miniEventsUcase)
-
-	eventsPostsRepo := events_posts_repository.NewEventsPostsRepository(postgresDB.GetDatabase())
-	eventsPostsUcse := events_posts_usecase.NewEventsPostsUsecase(eventsPostsRepo)
-	eventsPostsHandler := events_posts_delivery.NewEventsPostsHandler(eventsPostsUcse)
-
-	mw := middleware.NewMiddleware(userUcase)
-
-	router := mux.NewRouter()
-
-	static := router.PathPrefix("/img").Subrouter()
-	static.Handle("/clubs/{key}", http.FileServer(http.Dir("."))).Methods(http.MethodGet)
-	static.Handle("/events/{key}", http.FileServer(http.Dir("."))).Methods(http.MethodGet)
-	static.Handle("/cars/{key}", http.FileServer(http.Dir("."))).Methods(http.MethodGet)
-	static.Handle("/events-posts/{key}", http.FileServer(http.Dir("."))).Methods(http.MethodGet)
-
-	api := router.PathPrefix("/api/v1").Subrouter()
-	api.Use(middleware.CorsControlMiddleware)
-	eventHandler.Configure(api, mw)
-	clubsHandler.Configure(api, mw)
-	userHandler.Configure(api, mw)
-	miniEventHandler.Configure(api, mw)
-	eventsPostsHandler.Configure(api, mw)
-	api.PathPrefix("/swagger").Handler(httpSwagger.WrapHandler)
-
-	server := http.Server{
-		Addr:         ":8080",
-		Handler:      router,
-		ReadTimeout:  60 * time.Second,
-		WriteTimeout: 60 * time.Second,
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	err = server.ListenAndServe()
+	err = cmd.run(cfg, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
 	if err != nil {
-		log.Fatal(err)
+		log.Fatalf("%s: %v", cmd.name, err)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: %s <command> [flags]\n\ncommands:\n", os.Args[0])
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", cmd.name, cmd.description)
 	}
 }
@@ -0,0 +1,63 @@
+// Package carlifepb is the gRPC API of car-life-api for the other backend services. The messages and
+// the service clients are generated from proto/carlife/v1 with make proto; Client bundles the clients.
+package carlifepb
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Client calls every service of the API over one connection.
+type Client struct {
+	UsersServiceClient
+	ClubsServiceClient
+	EventsServiceClient
+	PostsServiceClient
+	MiniEventsServiceClient
+
+	conn *grpc.ClientConn
+}
+
+// Dial connects to the gRPC server at target, e.g. car-life-api:9090. Pass
+// grpc.WithTransportCredentials for the credentials of the connection.
+func Dial(target string, opts ...grpc.DialOption) (*Client, error) {
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	client := NewClient(conn)
+	client.conn = conn
+	return client, nil
+}
+
+// NewClient is a Client over a connection the caller owns and closes.
+func NewClient(cc grpc.ClientConnInterface) *Client {
+	return &Client{
+		UsersServiceClient:      NewUsersServiceClient(cc),
+		ClubsServiceClient:      NewClubsServiceClient(cc),
+		EventsServiceClient:     NewEventsServiceClient(cc),
+		PostsServiceClient:      NewPostsServiceClient(cc),
+		MiniEventsServiceClient: NewMiniEventsServiceClient(cc),
+	}
+}
+
+// Close closes the connection opened by Dial.
+func (c *Client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// WithSession makes the calls with ctx on behalf of the user of the session, the value
+// the HTTP API takes in the auth header. Calls without a session are anonymous.
+func WithSession(ctx context.Context, session string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "auth", session)
+}
+
+// WithRequestID sets the id the server logs the calls with ctx under, to follow a request across services.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "x-request-id", requestID)
+}
@@ -0,0 +1,325 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: carlife/v1/clubs.proto
+
+package carlifepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ClubsServiceClient is the client API for ClubsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClubsServiceClient interface {
+	GetClub(ctx context.Context, in *GetClubRequest, opts ...grpc.CallOption) (*Club, error)
+	// GetClubs returns the clubs that exist among the ids, in no particular order.
+	GetClubs(ctx context.Context, in *GetClubsRequest, opts ...grpc.CallOption) (*GetClubsResponse, error)
+	ListClubs(ctx context.Context, in *ListClubsRequest, opts ...grpc.CallOption) (*ClubList, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// ListClubMembers lists the participant requests only to the admin and the moderators of the club.
+	ListClubMembers(ctx context.Context, in *ListClubMembersRequest, opts ...grpc.CallOption) (*UserCardList, error)
+	ListClubCars(ctx context.Context, in *ListClubCarsRequest, opts ...grpc.CallOption) (*CarList, error)
+	ListClubEvents(ctx context.Context, in *ListClubEventsRequest, opts ...grpc.CallOption) (*EventCardList, error)
+}
+
+type clubsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClubsServiceClient(cc grpc.ClientConnInterface) ClubsServiceClient {
+	return &clubsServiceClient{cc}
+}
+
+func (c *clubsServiceClient) GetClub(ctx context.Context, in *GetClubRequest, opts ...grpc.CallOption) (*Club, error) {
+	out := new(Club)
+	err := c.cc.Invoke(ctx, "/carlife.v1.ClubsService/GetClub", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubsServiceClient) GetClubs(ctx context.Context, in *GetClubsRequest, opts ...grpc.CallOption) (*GetClubsResponse, error) {
+	out := new(GetClubsResponse)
+	err := c.cc.Invoke(ctx, "/carlife.v1.ClubsService/GetClubs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubsServiceClient) ListClubs(ctx context.Context, in *ListClubsRequest, opts ...grpc.CallOption) (*ClubList, error) {
+	out := new(ClubList)
+	err := c.cc.Invoke(ctx, "/carlife.v1.ClubsService/ListClubs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubsServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/carlife.v1.ClubsService/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubsServiceClient) ListClubMembers(ctx context.Context, in *ListClubMembersRequest, opts ...grpc.CallOption) (*UserCardList, error) {
+	out := new(UserCardList)
+	err := c.cc.Invoke(ctx, "/carlife.v1.ClubsService/ListClubMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubsServiceClient) ListClubCars(ctx context.Context, in *ListClubCarsRequest, opts ...grpc.CallOption) (*CarList, error) {
+	out := new(CarList)
+	err := c.cc.Invoke(ctx, "/carlife.v1.ClubsService/ListClubCars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubsServiceClient) ListClubEvents(ctx context.Context, in *ListClubEventsRequest, opts ...grpc.CallOption) (*EventCardList, error) {
+	out := new(EventCardList)
+	err := c.cc.Invoke(ctx, "/carlife.v1.ClubsService/ListClubEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClubsServiceServer is the server API for ClubsService service.
+// All implementations must embed UnimplementedClubsServiceServer
+// for forward compatibility
+type ClubsServiceServer interface {
+	GetClub(context.Context, *GetClubRequest) (*Club, error)
+	// GetClubs returns the clubs that exist among the ids, in no particular order.
+	GetClubs(context.Context, *GetClubsRequest) (*GetClubsResponse, error)
+	ListClubs(context.Context, *ListClubsRequest) (*ClubList, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// ListClubMembers lists the participant requests only to the admin and the moderators of the club.
+	ListClubMembers(context.Context, *ListClubMembersRequest) (*UserCardList, error)
+	ListClubCars(context.Context, *ListClubCarsRequest) (*CarList, error)
+	ListClubEvents(context.Context, *ListClubEventsRequest) (*EventCardList, error)
+	mustEmbedUnimplementedClubsServiceServer()
+}
+
+// UnimplementedClubsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedClubsServiceServer struct {
+}
+
+func (UnimplementedClubsServiceServer) GetClub(context.Context, *GetClubRequest) (*Club, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClub not implemented")
+}
+func (UnimplementedClubsServiceServer) GetClubs(context.Context, *GetClubsRequest) (*GetClubsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClubs not implemented")
+}
+func (UnimplementedClubsServiceServer) ListClubs(context.Context, *ListClubsRequest) (*ClubList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClubs not implemented")
+}
+func (UnimplementedClubsServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedClubsServiceServer) ListClubMembers(context.Context, *ListClubMembersRequest) (*UserCardList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClubMembers not implemented")
+}
+func (UnimplementedClubsServiceServer) ListClubCars(context.Context, *ListClubCarsRequest) (*CarList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClubCars not implemented")
+}
+func (UnimplementedClubsServiceServer) ListClubEvents(context.Context, *ListClubEventsRequest) (*EventCardList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClubEvents not implemented")
+}
+func (UnimplementedClubsServiceServer) mustEmbedUnimplementedClubsServiceServer() {}
+
+// UnsafeClubsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClubsServiceServer will
+// result in compilation errors.
+type UnsafeClubsServiceServer interface {
+	mustEmbedUnimplementedClubsServiceServer()
+}
+
+func RegisterClubsServiceServer(s grpc.ServiceRegistrar, srv ClubsServiceServer) {
+	s.RegisterService(&ClubsService_ServiceDesc, srv)
+}
+
+func _ClubsService_GetClub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubsServiceServer).GetClub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carlife.v1.ClubsService/GetClub",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubsServiceServer).GetClub(ctx, req.(*GetClubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubsService_GetClubs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClubsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubsServiceServer).GetClubs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carlife.v1.ClubsService/GetClubs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubsServiceServer).GetClubs(ctx, req.(*GetClubsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubsService_ListClubs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClubsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubsServiceServer).ListClubs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carlife.v1.ClubsService/ListClubs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubsServiceServer).ListClubs(ctx, req.(*ListClubsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubsService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubsServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carlife.v1.ClubsService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubsServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubsService_ListClubMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClubMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubsServiceServer).ListClubMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carlife.v1.ClubsService/ListClubMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubsServiceServer).ListClubMembers(ctx, req.(*ListClubMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubsService_ListClubCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClubCarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubsServiceServer).ListClubCars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carlife.v1.ClubsService/ListClubCars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubsServiceServer).ListClubCars(ctx, req.(*ListClubCarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubsService_ListClubEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClubEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubsServiceServer).ListClubEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carlife.v1.ClubsService/ListClubEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubsServiceServer).ListClubEvents(ctx, req.(*ListClubEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClubsService_ServiceDesc is the grpc.ServiceDesc for ClubsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClubsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carlife.v1.ClubsService",
+	HandlerType: (*ClubsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetClub",
+			Handler:    _ClubsService_GetClub_Handler,
+		},
+		{
+			MethodName: "GetClubs",
+			Handler:    _ClubsService_GetClubs_Handler,
+		},
+		{
+			MethodName: "ListClubs",
+			Handler:    _ClubsService_ListClubs_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _ClubsService_ListTags_Handler,
+		},
+		{
+			MethodName: "ListClubMembers",
+			Handler:    _ClubsService_ListClubMembers_Handler,
+		},
+		{
+			MethodName: "ListClubCars",
+			Handler:    _ClubsService_ListClubCars_Handler,
+		},
+		{
+			MethodName: "ListClubEvents",
+			Handler:    _ClubsService_ListClubEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "carlife/v1/clubs.proto",
+}
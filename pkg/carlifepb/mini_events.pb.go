@@ -0,0 +1,541 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: carlife/v1/mini_events.proto
+
+package carlifepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MiniEventType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *MiniEventType) Reset() {
+	*x = MiniEventType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_carlife_v1_mini_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MiniEventType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MiniEventType) ProtoMessage() {}
+
+func (x *MiniEventType) ProtoReflect() protoreflect.Message {
+	mi := &file_carlife_v1_mini_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MiniEventType.ProtoReflect.Descriptor instead.
+func (*MiniEventType) Descriptor() ([]byte, []int) {
+	return file_carlife_v1_mini_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MiniEventType) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MiniEventType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MiniEventType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type MiniEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type        *MiniEventType         `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Author      *UserCard              `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EndedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Latitude    float32                `protobuf:"fixed32,7,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude   float32                `protobuf:"fixed32,8,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *MiniEvent) Reset() {
+	*x = MiniEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_carlife_v1_mini_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MiniEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MiniEvent) ProtoMessage() {}
+
+func (x *MiniEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_carlife_v1_mini_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MiniEvent.ProtoReflect.Descriptor instead.
+func (*MiniEvent) Descriptor() ([]byte, []int) {
+	return file_carlife_v1_mini_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MiniEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MiniEvent) GetType() *MiniEventType {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *MiniEvent) GetAuthor() *UserCard {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *MiniEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MiniEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MiniEvent) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *MiniEvent) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *MiniEvent) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type MiniEventList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items      []*MiniEvent `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor string       `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore    bool         `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+}
+
+func (x *MiniEventList) Reset() {
+	*x = MiniEventList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_carlife_v1_mini_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MiniEventList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MiniEventList) ProtoMessage() {}
+
+func (x *MiniEventList) ProtoReflect() protoreflect.Message {
+	mi := &file_carlife_v1_mini_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MiniEventList.ProtoReflect.Descriptor instead.
+func (*MiniEventList) Descriptor() ([]byte, []int) {
+	return file_carlife_v1_mini_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MiniEventList) GetItems() []*MiniEvent {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *MiniEventList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *MiniEventList) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type GetMiniEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetMiniEventRequest) Reset() {
+	*x = GetMiniEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_carlife_v1_mini_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMiniEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMiniEventRequest) ProtoMessage() {}
+
+func (x *GetMiniEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carlife_v1_mini_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMiniEventRequest.ProtoReflect.Descriptor instead.
+func (*GetMiniEventRequest) Descriptor() ([]byte, []int) {
+	return file_carlife_v1_mini_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMiniEventRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListMiniEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page *Page `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *ListMiniEventsRequest) Reset() {
+	*x = ListMiniEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_carlife_v1_mini_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMiniEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMiniEventsRequest) ProtoMessage() {}
+
+func (x *ListMiniEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carlife_v1_mini_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMiniEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListMiniEventsRequest) Descriptor() ([]byte, []int) {
+	return file_carlife_v1_mini_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMiniEventsRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+var File_carlife_v1_mini_events_proto protoreflect.FileDescriptor
+
+var file_carlife_v1_mini_events_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x63, 0x61, 0x72, 0x6c, 0x69, 0x66, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e,
+	0x69, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a,
+	0x63, 0x61, 0x72, 0x6c, 0x69, 0x66, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x63, 0x61, 0x72, 0x6c,
+	0x69, 0x66, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x55, 0x0a, 0x0d, 0x4d, 0x69, 0x6e, 0x69, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc6, 0x02, 0x0a, 0x09,
+	0x4d, 0x69, 0x6e, 0x69, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x6c, 0x69, 0x66,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x72, 0x6c, 0x69,
+	0x66, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x61, 0x72, 0x64, 0x52, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x22, 0x78, 0x0a, 0x0d, 0x4d, 0x69, 0x6e, 0x69, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x6c, 0x69, 0x66, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x25,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3d, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e,
+	0x69, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
+	0x61, 0x72, 0x6c, 0x69, 0x66, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x32, 0xab, 0x01, 0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x69, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x4d, 0x69, 0x6e, 0x69, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72,
+	0x6c, 0x69, 0x66, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x61,
+	0x72, 0x6c, 0x69, 0x66, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x61, 0x72, 0x6c, 0x69, 0x66, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x6c, 0x69, 0x66,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x64, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x6f, 0x79, 0x6c, 0x2f, 0x63, 0x61, 0x72, 0x2d, 0x6c,
+	0x69, 0x66, 0x65, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x61, 0x72, 0x6c,
+	0x69, 0x66, 0x65, 0x70, 0x62, 0x3b, 0x63, 0x61, 0x72, 0x6c, 0x69, 0x66, 0x65, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_carlife_v1_mini_events_proto_rawDescOnce sync.Once
+	file_carlife_v1_mini_events_proto_rawDescData = file_carlife_v1_mini_events_proto_rawDesc
+)
+
+func file_carlife_v1_mini_events_proto_rawDescGZIP() []byte {
+	file_carlife_v1_mini_events_proto_rawDescOnce.Do(func() {
+		file_carlife_v1_mini_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_carlife_v1_mini_events_proto_rawDescData)
+	})
+	return file_carlife_v1_mini_events_proto_rawDescData
+}
+
+var file_carlife_v1_mini_events_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_carlife_v1_mini_events_proto_goTypes = []interface{}{
+	(*MiniEventType)(nil),         // 0: carlife.v1.MiniEventType
+	(*MiniEvent)(nil),             // 1: carlife.v1.MiniEvent
+	(*MiniEventList)(nil),         // 2: carlife.v1.MiniEventList
+	(*GetMiniEventRequest)(nil),   // 3: carlife.v1.GetMiniEventRequest
+	(*ListMiniEventsRequest)(nil), // 4: carlife.v1.ListMiniEventsRequest
+	(*UserCard)(nil),              // 5: carlife.v1.UserCard
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*Page)(nil),                  // 7: carlife.v1.Page
+}
+var file_carlife_v1_mini_events_proto_depIdxs = []int32{
+	0, // 0: carlife.v1.MiniEvent.type:type_name -> carlife.v1.MiniEventType
+	5, // 1: carlife.v1.MiniEvent.author:type_name -> carlife.v1.UserCard
+	6, // 2: carlife.v1.MiniEvent.created_at:type_name -> google.protobuf.Timestamp
+	6, // 3: carlife.v1.MiniEvent.ended_at:type_name -> google.protobuf.Timestamp
+	1, // 4: carlife.v1.MiniEventList.items:type_name -> carlife.v1.MiniEvent
+	7, // 5: carlife.v1.ListMiniEventsRequest.page:type_name -> carlife.v1.Page
+	3, // 6: carlife.v1.MiniEventsService.GetMiniEvent:input_type -> carlife.v1.GetMiniEventRequest
+	4, // 7: carlife.v1.MiniEventsService.ListMiniEvents:input_type -> carlife.v1.ListMiniEventsRequest
+	1, // 8: carlife.v1.MiniEventsService.GetMiniEvent:output_type -> carlife.v1.MiniEvent
+	2, // 9: carlife.v1.MiniEventsService.ListMiniEvents:output_type -> carlife.v1.MiniEventList
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_carlife_v1_mini_events_proto_init() }
+func file_carlife_v1_mini_events_proto_init() {
+	if File_carlife_v1_mini_events_proto != nil {
+		return
+	}
+	file_carlife_v1_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_carlife_v1_mini_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MiniEventType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_carlife_v1_mini_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MiniEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_carlife_v1_mini_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MiniEventList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_carlife_v1_mini_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMiniEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_carlife_v1_mini_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMiniEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_carlife_v1_mini_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_carlife_v1_mini_events_proto_goTypes,
+		DependencyIndexes: file_carlife_v1_mini_events_proto_depIdxs,
+		MessageInfos:      file_carlife_v1_mini_events_proto_msgTypes,
+	}.Build()
+	File_carlife_v1_mini_events_proto = out.File
+	file_carlife_v1_mini_events_proto_rawDesc = nil
+	file_carlife_v1_mini_events_proto_goTypes = nil
+	file_carlife_v1_mini_events_proto_depIdxs = nil
+}
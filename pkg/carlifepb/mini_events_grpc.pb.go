@@ -0,0 +1,143 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: carlife/v1/mini_events.proto
+
+package carlifepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// MiniEventsServiceClient is the client API for MiniEventsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MiniEventsServiceClient interface {
+	GetMiniEvent(ctx context.Context, in *GetMiniEventRequest, opts ...grpc.CallOption) (*MiniEvent, error)
+	// ListMiniEvents lists the mini events that haven't ended yet.
+	ListMiniEvents(ctx context.Context, in *ListMiniEventsRequest, opts ...grpc.CallOption) (*MiniEventList, error)
+}
+
+type miniEventsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMiniEventsServiceClient(cc grpc.ClientConnInterface) MiniEventsServiceClient {
+	return &miniEventsServiceClient{cc}
+}
+
+func (c *miniEventsServiceClient) GetMiniEvent(ctx context.Context, in *GetMiniEventRequest, opts ...grpc.CallOption) (*MiniEvent, error) {
+	out := new(MiniEvent)
+	err := c.cc.Invoke(ctx, "/carlife.v1.MiniEventsService/GetMiniEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniEventsServiceClient) ListMiniEvents(ctx context.Context, in *ListMiniEventsRequest, opts ...grpc.CallOption) (*MiniEventList, error) {
+	out := new(MiniEventList)
+	err := c.cc.Invoke(ctx, "/carlife.v1.MiniEventsService/ListMiniEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MiniEventsServiceServer is the server API for MiniEventsService service.
+// All implementations must embed UnimplementedMiniEventsServiceServer
+// for forward compatibility
+type MiniEventsServiceServer interface {
+	GetMiniEvent(context.Context, *GetMiniEventRequest) (*MiniEvent, error)
+	// ListMiniEvents lists the mini events that haven't ended yet.
+	ListMiniEvents(context.Context, *ListMiniEventsRequest) (*MiniEventList, error)
+	mustEmbedUnimplementedMiniEventsServiceServer()
+}
+
+// UnimplementedMiniEventsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMiniEventsServiceServer struct {
+}
+
+func (UnimplementedMiniEventsServiceServer) GetMiniEvent(context.Context, *GetMiniEventRequest) (*MiniEvent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMiniEvent not implemented")
+}
+func (UnimplementedMiniEventsServiceServer) ListMiniEvents(context.Context, *ListMiniEventsRequest) (*MiniEventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMiniEvents not implemented")
+}
+func (UnimplementedMiniEventsServiceServer) mustEmbedUnimplementedMiniEventsServiceServer() {}
+
+// UnsafeMiniEventsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MiniEventsServiceServer will
+// result in compilation errors.
+type UnsafeMiniEventsServiceServer interface {
+	mustEmbedUnimplementedMiniEventsServiceServer()
+}
+
+func RegisterMiniEventsServiceServer(s grpc.ServiceRegistrar, srv MiniEventsServiceServer) {
+	s.RegisterService(&MiniEventsService_ServiceDesc, srv)
+}
+
+func _MiniEventsService_GetMiniEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMiniEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniEventsServiceServer).GetMiniEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carlife.v1.MiniEventsService/GetMiniEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniEventsServiceServer).GetMiniEvent(ctx, req.(*GetMiniEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniEventsService_ListMiniEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMiniEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniEventsServiceServer).ListMiniEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carlife.v1.MiniEventsService/ListMiniEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniEventsServiceServer).ListMiniEvents(ctx, req.(*ListMiniEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MiniEventsService_ServiceDesc is the grpc.ServiceDesc for MiniEventsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MiniEventsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carlife.v1.MiniEventsService",
+	HandlerType: (*MiniEventsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMiniEvent",
+			Handler:    _MiniEventsService_GetMiniEvent_Handler,
+		},
+		{
+			MethodName: "ListMiniEvents",
+			Handler:    _MiniEventsService_ListMiniEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "carlife/v1/mini_events.proto",
+}
@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: carlife/v1/posts.proto
+
+package carlifepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PostsServiceClient is the client API for PostsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PostsServiceClient interface {
+	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*Post, error)
+	ListEventPosts(ctx context.Context, in *ListEventPostsRequest, opts ...grpc.CallOption) (*PostList, error)
+}
+
+type postsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPostsServiceClient(cc grpc.ClientConnInterface) PostsServiceClient {
+	return &postsServiceClient{cc}
+}
+
+func (c *postsServiceClient) GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*Post, error) {
+	out := new(Post)
+	err := c.cc.Invoke(ctx, "/carlife.v1.PostsService/GetPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsServiceClient) ListEventPosts(ctx context.Context, in *ListEventPostsRequest, opts ...grpc.CallOption) (*PostList, error) {
+	out := new(PostList)
+	err := c.cc.Invoke(ctx, "/carlife.v1.PostsService/ListEventPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PostsServiceServer is the server API for PostsService service.
+// All implementations must embed UnimplementedPostsServiceServer
+// for forward compatibility
+type PostsServiceServer interface {
+	GetPost(context.Context, *GetPostRequest) (*Post, error)
+	ListEventPosts(context.Context, *ListEventPostsRequest) (*PostList, error)
+	mustEmbedUnimplementedPostsServiceServer()
+}
+
+// UnimplementedPostsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPostsServiceServer struct {
+}
+
+func (UnimplementedPostsServiceServer) GetPost(context.Context, *GetPostRequest) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+}
+func (UnimplementedPostsServiceServer) ListEventPosts(context.Context, *ListEventPostsRequest) (*PostList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventPosts not implemented")
+}
+func (UnimplementedPostsServiceServer) mustEmbedUnimplementedPostsServiceServer() {}
+
+// UnsafePostsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PostsServiceServer will
+// result in compilation errors.
+type UnsafePostsServiceServer interface {
+	mustEmbedUnimplementedPostsServiceServer()
+}
+
+func RegisterPostsServiceServer(s grpc.ServiceRegistrar, srv PostsServiceServer) {
+	s.RegisterService(&PostsService_ServiceDesc, srv)
+}
+
+func _PostsService_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).GetPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carlife.v1.PostsService/GetPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).GetPost(ctx, req.(*GetPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostsService_ListEventPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServiceServer).ListEventPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carlife.v1.PostsService/ListEventPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServiceServer).ListEventPosts(ctx, req.(*ListEventPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PostsService_ServiceDesc is the grpc.ServiceDesc for PostsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PostsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "carlife.v1.PostsService",
+	HandlerType: (*PostsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPost",
+			Handler:    _PostsService_GetPost_Handler,
+		},
+		{
+			MethodName: "ListEventPosts",
+			Handler:    _PostsService_ListEventPosts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "carlife/v1/posts.proto",
+}
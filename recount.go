@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/dantedoyl/car-life-api/internal/app/bootstrap"
+	"github.com/dantedoyl/car-life-api/internal/app/config"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"github.com/dantedoyl/car-life-api/internal/app/maintenance"
+	"os"
+)
+
+func runRecount(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("recount", flag.ContinueOnError)
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	app, err := bootstrap.New(cfg, logger.New(os.Stderr))
+	if err != nil {
+		return err
+	}
+	defer app.Close()
+
+	m := maintenance.NewMaintenance(app.Postgres.GetDatabase(), app.Sessions, app.Idempotency)
+
+	fixed, err := m.RecountClubs()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("fixed counters of %d clubs\n", fixed)
+
+	fixed, err = m.RecountEvents()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("fixed counters of %d events\n", fixed)
+
+	return nil
+}
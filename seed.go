@@ -0,0 +1,46 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/dantedoyl/car-life-api/internal/app/bootstrap"
+	"github.com/dantedoyl/car-life-api/internal/app/config"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"github.com/dantedoyl/car-life-api/internal/app/maintenance"
+	"github.com/dantedoyl/car-life-api/internal/app/seed"
+	"os"
+)
+
+func runSeed(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	app, err := bootstrap.New(cfg, logger.New(os.Stderr))
+	if err != nil {
+		return err
+	}
+	defer app.Close()
+
+	err = seed.NewSeeder(app.Users, app.Clubs, app.Events, app.MiniEvents).Run()
+	if errors.Is(err, seed.ErrAlreadySeeded) {
+		fmt.Println(err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Creating events doesn't maintain clubs.events_count, so bring the counters in line.
+	m := maintenance.NewMaintenance(app.Postgres.GetDatabase(), app.Sessions, app.Idempotency)
+	_, err = m.RecountClubs()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("demo data loaded")
+	return nil
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"github.com/dantedoyl/car-life-api/internal/app/bootstrap"
+	"github.com/dantedoyl/car-life-api/internal/app/config"
+	"github.com/dantedoyl/car-life-api/internal/app/logger"
+	"google.golang.org/grpc"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+func runServe(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	appLog := logger.New(os.Stdout)
+	app, err := bootstrap.New(cfg, appLog)
+	if err != nil {
+		return err
+	}
+	// Dependencies are closed only after the server has stopped handling requests.
+	defer app.Close()
+
+	server := http.Server{
+		Addr:         cfg.Server.Addr,
+		Handler:      app.Router(),
+		ReadTimeout:  cfg.Server.ReadTimeout,
+		WriteTimeout: cfg.Server.WriteTimeout,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	serverErr := make(chan error, 3)
+	go func() {
+		serverErr <- server.ListenAndServe()
+	}()
+
+	var metricsServer *http.Server
+	if cfg.Server.MetricsAddr != "" {
+		metricsServer = &http.Server{
+			Addr:         cfg.Server.MetricsAddr,
+			Handler:      app.MetricsRouter(),
+			ReadTimeout:  cfg.Server.ReadTimeout,
+			WriteTimeout: cfg.Server.WriteTimeout,
+		}
+		go func() {
+			serverErr <- metricsServer.ListenAndServe()
+		}()
+	}
+
+	var grpcServer *grpc.Server
+	if cfg.Server.GRPCAddr != "" {
+		listener, err := net.Listen("tcp", cfg.Server.GRPCAddr)
+		if err != nil {
+			return err
+		}
+		grpcServer = app.GRPCServer()
+		go func() {
+			serverErr <- grpcServer.Serve(listener)
+		}()
+	}
+
+	select {
+	case err = <-serverErr:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	case <-ctx.Done():
+		appLog.Info("shutting down")
+		app.Health.SetDraining()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+		defer cancel()
+		grpcStopped := make(chan struct{})
+		go func() {
+			if grpcServer != nil {
+				stopGRPC(shutdownCtx, grpcServer)
+			}
+			close(grpcStopped)
+		}()
+		err = server.Shutdown(shutdownCtx)
+		if metricsServer != nil {
+			metricsServer.Shutdown(shutdownCtx)
+		}
+		// The dependencies the gRPC calls use are closed once runServe returns.
+		<-grpcStopped
+		return err
+	}
+}
+
+// stopGRPC lets the calls in flight finish like http.Server.Shutdown does, and cancels them when ctx is done.
+func stopGRPC(ctx context.Context, server *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.Stop()
+	}
+}